@@ -0,0 +1,234 @@
+package opendata
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache stores raw OpenData responses keyed by request URL, which includes the page number.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// CacheEntry is a successful response body stored in a Cache.
+type CacheEntry struct {
+	Body   []byte    `json:"body"`
+	Stored time.Time `json:"stored"`
+}
+
+// CachePolicy controls how long cached responses are served.
+type CachePolicy struct {
+	// TTL is how long an entry is fresh. A zero TTL disables caching.
+	TTL time.Duration
+	// StaleWhileRevalidate is how long after expiry a stale entry is served
+	// while it is refreshed in the background.
+	StaleWhileRevalidate time.Duration
+	// StaleIfError is how long after expiry a stale entry is served when the upstream request fails.
+	// It does not apply to requests that bypass the cache, such as Registrar.RefreshParameters and Watcher polls.
+	StaleIfError time.Duration
+}
+
+// WithCache enables response caching with the given default policy for every endpoint.
+func WithCache(cache Cache, policy CachePolicy) Option {
+	return func(o *OpenData) {
+		o.cache = cache
+		o.cacheDefault = policy
+	}
+}
+
+// WithEndpointCachePolicy overrides the cache policy of a single endpoint, such as EndpointCourseCatalog.
+// Use a zero policy to disable caching for the endpoint.
+func WithEndpointCachePolicy(endpoint string, policy CachePolicy) Option {
+	return func(o *OpenData) {
+		if o.cachePolicies == nil {
+			o.cachePolicies = make(map[string]CachePolicy)
+		}
+		o.cachePolicies[endpoint] = policy
+	}
+}
+
+func (o *OpenData) cachePolicy(req *http.Request) (CachePolicy, bool) {
+	if o.cache == nil || req.Method != http.MethodGet {
+		return CachePolicy{}, false
+	}
+	policy, ok := o.cachePolicies[o.endpoint(req.URL)]
+	if !ok {
+		policy = o.cacheDefault
+	}
+	return policy, policy.TTL > 0
+}
+
+// cachedGet serves the request from the cache when possible.
+// A request with a "Cache-Control: no-cache" header never reads the cache, even on error,
+// but still updates it.
+func (o *OpenData) cachedGet(ctx context.Context, req *http.Request, v *data, policy CachePolicy) error {
+	key := req.URL.String()
+	var entry *CacheEntry
+	var age time.Duration
+	ok := false
+	if req.Header.Get("Cache-Control") != "no-cache" {
+		entry, ok = o.cache.Get(key)
+	}
+	if ok {
+		age = time.Since(entry.Stored)
+		if age < policy.TTL {
+			return json.Unmarshal(entry.Body, v)
+		}
+		if age < policy.TTL+policy.StaleWhileRevalidate {
+			o.revalidate(req, key)
+			return json.Unmarshal(entry.Body, v)
+		}
+	}
+
+	body, err := o.fetch(ctx, req, v)
+	if err != nil {
+		if ok && ctx.Err() == nil && age < policy.TTL+policy.StaleIfError {
+			// Drop whatever was decoded from the failed response.
+			*v = data{}
+			return json.Unmarshal(entry.Body, v)
+		}
+		return err
+	}
+	o.cache.Set(key, &CacheEntry{Body: body, Stored: time.Now()})
+	return nil
+}
+
+// revalidate refreshes the cache entry in the background, at most once at a time per key.
+func (o *OpenData) revalidate(req *http.Request, key string) {
+	if _, loaded := o.revalidating.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+	req = req.Clone(context.Background())
+	go func() {
+		defer o.revalidating.Delete(key)
+		if body, err := o.fetch(req.Context(), req, new(data)); err == nil {
+			o.cache.Set(key, &CacheEntry{Body: body, Stored: time.Now()})
+		}
+	}()
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entries.
+type MemoryCache struct {
+	lock     sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache generates a MemoryCache holding at most capacity entries.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{capacity: capacity, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// Get gets the entry with the given key and marks it as recently used.
+func (c *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*memoryCacheItem).entry, true
+}
+
+// Set stores the entry, evicting the least recently used entry if the cache is full.
+func (c *MemoryCache) Set(key string, entry *CacheEntry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*memoryCacheItem).entry = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&memoryCacheItem{key: key, entry: entry})
+	for c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// Delete removes the entry with the given key.
+func (c *MemoryCache) Delete(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// DiskCache is a Cache that stores each entry as a file in a directory.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache generates a DiskCache in the given directory, creating it if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get reads the entry with the given key. Unreadable entries are treated as missing.
+func (c *DiskCache) Get(key string) (*CacheEntry, bool) {
+	raw, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	entry := new(CacheEntry)
+	if err := json.Unmarshal(raw, entry); err != nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+// Set atomically writes the entry with the given key. Write errors are ignored.
+func (c *DiskCache) Set(key string, entry *CacheEntry) {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	file, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = file.Write(raw)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), c.path(key))
+	}
+	if err != nil {
+		os.Remove(file.Name())
+	}
+}
+
+// Delete removes the entry with the given key.
+func (c *DiskCache) Delete(key string) {
+	os.Remove(c.path(key))
+}
@@ -0,0 +1,99 @@
+package opendata
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheEviction(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", &CacheEntry{Body: []byte("a")})
+	c.Set("b", &CacheEntry{Body: []byte("b")})
+	c.Get("a")
+	c.Set("c", &CacheEntry{Body: []byte("c")})
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("least recently used entry was not evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("recently used entry was evicted")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	c, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Set("key", &CacheEntry{Body: []byte(`{}`), Stored: time.Now()})
+	if entry, ok := c.Get("key"); !ok || string(entry.Body) != `{}` {
+		t.Fatal("entry was not stored")
+	}
+	c.Delete("key")
+	if _, ok := c.Get("key"); ok {
+		t.Fatal("entry was not deleted")
+	}
+}
+
+func TestCacheHitAndStaleIfError(t *testing.T) {
+	calls := 0
+	fail := false
+	cache := NewMemoryCache(10)
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"service_meta":{"error":true,"error_text":"down","next_page_number":7}}`))
+			return
+		}
+		w.Write([]byte(`{"result_data":[{}],"service_meta":{"current_page_number":1,"number_of_pages":1}}`))
+	}, WithCache(cache, CachePolicy{TTL: time.Hour, StaleIfError: time.Hour}))
+
+	for i := 0; i < 2; i++ {
+		iter := od.GetRegistrar().GetCourseCatalog("NETS", "")
+		if !iter.NextPage() || iter.GetError() != nil {
+			t.Fatal(iter.GetError())
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("calls = %d", calls)
+	}
+
+	for _, elem := range cache.entries {
+		elem.Value.(*memoryCacheItem).entry.Stored = time.Now().Add(-90 * time.Minute)
+	}
+	fail = true
+	iter := od.GetRegistrar().GetCourseCatalog("NETS", "")
+	if !iter.NextPage() || iter.GetError() != nil || iter.GetPageSize() != 1 {
+		t.Fatal(iter.GetError())
+	}
+	if meta := iter.data.ServiceMeta; meta.Error || meta.ErrorText != "" || meta.NextPageNumber != 0 {
+		t.Fatalf("service_meta of the failed response leaked: %+v", meta)
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d", calls)
+	}
+}
+
+func TestCacheNoCacheSkipsStaleIfError(t *testing.T) {
+	fail := false
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(testParameters))
+	}, WithCache(NewMemoryCache(10), CachePolicy{TTL: time.Hour, StaleIfError: time.Hour}))
+	r := od.GetRegistrar()
+	if _, err := r.GetAvailableTermMap(); err != nil {
+		t.Fatal(err)
+	}
+	fail = true
+	if err := r.RefreshParameters(context.Background()); err == nil {
+		t.Fatal("refresh was served from the cache")
+	}
+	if _, err := r.GetAvailableTermMap(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+package opendata
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ConsistencyPolicy controls how a PageIterator reacts when the result set shifts during a crawl.
+type ConsistencyPolicy int
+
+const (
+	// ConsistencyIgnore does not check pages for consistency.
+	ConsistencyIgnore ConsistencyPolicy = iota
+	// ConsistencyWarn records a ConsistencyWarning and continues.
+	ConsistencyWarn
+	// ConsistencyRestart records a ConsistencyWarning and restarts the crawl from the first page.
+	// It is best combined with DedupeBy so that already returned results are skipped.
+	ConsistencyRestart
+)
+
+// maxConsistencyRestarts limits how often ConsistencyRestart restarts a single crawl.
+const maxConsistencyRestarts = 3
+
+// ConsistencyWarning describes a change of the result set detected while paging,
+// or a page size the server did not honour.
+type ConsistencyWarning struct {
+	// Page is the requested page number.
+	Page int
+	// Field is the service_meta field that changed.
+	Field string
+	// Expected is the value seen on the first page of the crawl, or the requested page number or size.
+	Expected int
+	// Actual is the value reported by the page.
+	Actual int
+	// Restarted reports whether the crawl was restarted because of the change.
+	Restarted bool
+}
+
+func (w *ConsistencyWarning) String() string {
+	return fmt.Sprintf("page %d: %s changed from %d to %d", w.Page, w.Field, w.Expected, w.Actual)
+}
+
+// CheckConsistency enables detection of result sets that change while paging,
+// such as a changed number of pages or results per page.
+func (i *PageIterator[T]) CheckConsistency(policy ConsistencyPolicy) *PageIterator[T] {
+	i.consistency = policy
+	return i
+}
+
+// DedupeBy skips results whose key was already returned by the iterator.
+// Use CourseSearchData.Key for section searches.
+func (i *PageIterator[T]) DedupeBy(key func(*T) string) *PageIterator[T] {
+	i.key = key
+	i.seen = make(map[string]struct{})
+	return i
+}
+
+// Warnings gets the consistency warnings recorded so far.
+func (i *PageIterator[T]) Warnings() []*ConsistencyWarning {
+	return i.warnings
+}
+
+// checkConsistency compares the page with the first page of the crawl.
+// It returns true if the crawl must be restarted.
+func (i *PageIterator[T]) checkConsistency(data *data) bool {
+	if i.consistency == ConsistencyIgnore {
+		return false
+	}
+	meta := data.ServiceMeta
+	if i.baseline == nil {
+		i.baseline = &meta
+	}
+
+	var warnings []*ConsistencyWarning
+	check := func(field string, expected, actual int) {
+		if expected != actual {
+			warnings = append(warnings, &ConsistencyWarning{Page: i.cur, Field: field, Expected: expected, Actual: actual})
+		}
+	}
+	check("current_page_number", i.cur, meta.CurrentPageNumber)
+	check("number_of_pages", i.baseline.NumberOfPages, meta.NumberOfPages)
+	check("results_per_page", i.baseline.ResultsPerPage, meta.ResultsPerPage)
+	if len(warnings) == 0 {
+		return false
+	}
+
+	restart := i.consistency == ConsistencyRestart && i.restarts < maxConsistencyRestarts && i.cur != 1
+	for _, warning := range warnings {
+		warning.Restarted = restart
+	}
+	i.warnings = append(i.warnings, warnings...)
+	if restart {
+		i.restarts++
+		i.baseline = nil
+	} else {
+		i.baseline = &meta
+	}
+	return restart
+}
+
+// checkPageSize records a warning the first time a page does not have the requested results per page.
+// Unlike other consistency checks it runs regardless of the ConsistencyPolicy.
+func (i *PageIterator[T]) checkPageSize(data *data) {
+	actual := data.ServiceMeta.ResultsPerPage
+	if i.size <= 0 || actual == i.size || i.sizeWarned == i.size {
+		return
+	}
+	i.sizeWarned = i.size
+	i.warnings = append(i.warnings, &ConsistencyWarning{Page: i.cur, Field: "results_per_page", Expected: i.size, Actual: actual})
+}
+
+// dedupe removes results that were already returned from the page.
+func (i *PageIterator[T]) dedupe(data *data) {
+	if i.key == nil {
+		return
+	}
+	results := data.ResultData[:0]
+	for _, raw := range data.ResultData {
+		result := new(T)
+		if err := json.Unmarshal(raw, result); err == nil {
+			key := i.key(result)
+			if _, ok := i.seen[key]; ok {
+				continue
+			}
+			i.seen[key] = struct{}{}
+		}
+		results = append(results, raw)
+	}
+	data.ResultData = results
+}
+
+// Key identifies the section within its term by CRN, falling back to the section ID.
+func (c *CourseSearchData) Key() string {
+	if c.Crn != "" {
+		return c.Term + "/" + c.Crn
+	}
+	return c.Term + "/" + c.SectionId
+}
@@ -0,0 +1,68 @@
+package opendata
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// shiftingHandler serves sections S1..Sn two per page, inserting S0 at the front after the first request.
+func shiftingHandler(sections int) http.HandlerFunc {
+	requests := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		ids := make([]int, 0, sections+1)
+		if requests > 1 {
+			ids = append(ids, 0)
+		}
+		for k := 1; k <= sections; k++ {
+			ids = append(ids, k)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page_number"))
+		pages := (len(ids) + 1) / 2
+		results := ""
+		for k := (page - 1) * 2; k < page*2 && k < len(ids); k++ {
+			if results != "" {
+				results += ","
+			}
+			results += fmt.Sprintf(`{"term":"202230","crn":"%d"}`, ids[k])
+		}
+		fmt.Fprintf(w, `{"result_data":[%s],"service_meta":{"current_page_number":%d,"next_page_number":%d,"number_of_pages":%d,"results_per_page":2}}`,
+			results, page, page+1, pages)
+	}
+}
+
+func TestConsistencyWarn(t *testing.T) {
+	od := newTestAPI(t, shiftingHandler(4))
+	iter := od.GetRegistrar().SearchCourseSection(nil).CheckConsistency(ConsistencyWarn)
+	for _, err := range iter.All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	warnings := iter.Warnings()
+	if len(warnings) != 1 || warnings[0].Field != "number_of_pages" || warnings[0].Restarted {
+		t.Fatal(warnings)
+	}
+}
+
+func TestConsistencyRestartDedupe(t *testing.T) {
+	od := newTestAPI(t, shiftingHandler(4))
+	iter := od.GetRegistrar().SearchCourseSection(nil).
+		CheckConsistency(ConsistencyRestart).
+		DedupeBy((*CourseSearchData).Key)
+	var crns []string
+	for result, err := range iter.All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		crns = append(crns, result.Crn)
+	}
+	if fmt.Sprint(crns) != "[1 2 0 3 4]" {
+		t.Fatal(crns)
+	}
+	if len(iter.Warnings()) != 1 || !iter.Warnings()[0].Restarted {
+		t.Fatal(iter.Warnings())
+	}
+}
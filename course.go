@@ -1,6 +1,8 @@
 package opendata
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -27,22 +29,164 @@ func validCourse(course string) bool {
 
 // NewCourse generates a new Course instance based on course subject, number, and section ID.
 func NewCourse(subject, number, section string) *Course {
-	subject = strings.ToUpper(strings.TrimSpace(subject))
+	id := NewCourseID(subject, number)
 	section = strings.ToUpper(strings.TrimSpace(section))
-	if len(subject) > 4 || len(subject) <= 1 || !validCourse(number) || len(section) != 3 {
+	if id == nil || len(section) != 3 {
 		return nil
 	}
-	return &Course{fmt.Sprintf("%s%04s%s", subject, number, section)}
+	return &Course{id.string + section}
 }
 
-var courseRegex = regexp.MustCompile(`^([a-zA-Z]{2,4})\s*-?(\d{2,4}[abAB]?)-?([\da-zA-Z]{3})$`)
+var courseRegex = regexp.MustCompile(`^([a-zA-Z]{2,4})\s*-?\s*(\d{2,4}[abAB]?)\s*-?\s*([\da-zA-Z]{3})$`)
 
 // ParseCourse generates a new Course instance based on course ID string using regex to match.
+// Every style produced by Course.Format is accepted.
 // 	ParseCourse("MUSC0050003")
-func ParseCourse(course string) *Course {
-	match := courseRegex.FindStringSubmatch(course)
+func ParseCourse(course string, options ...ParseOption) *Course {
+	match := courseRegex.FindStringSubmatch(strings.TrimSpace(course))
 	if len(match) != 4 {
 		return nil
 	}
-	return NewCourse(match[1], match[2], match[3])
+	number, ok := newParseConfig(options).currentNumber(match[1], match[2])
+	if !ok {
+		return nil
+	}
+	return NewCourse(match[1], number, match[3])
+}
+
+// subjectLen gets the length of the leading subject letters of a normalized ID.
+func subjectLen(id string) int {
+	i := 0
+	for i < len(id) && (id[i] < '0' || id[i] > '9') {
+		i++
+	}
+	return i
+}
+
+// Subject gets the subject of the course, e.g. "CIS".
+func (c Course) Subject() string {
+	return c.string[:subjectLen(c.string)]
+}
+
+// Number gets the four-digit course number, including any A/B suffix, e.g. "1200".
+func (c Course) Number() string {
+	if len(c.string) < 3 {
+		return ""
+	}
+	return c.string[subjectLen(c.string) : len(c.string)-3]
+}
+
+// CourseID gets the course the section belongs to.
+func (c Course) CourseID() *CourseID {
+	if len(c.string) < 3 {
+		return nil
+	}
+	return &CourseID{c.string[:len(c.string)-3]}
+}
+
+// Section gets the three-character section ID, e.g. "001".
+func (c Course) Section() string {
+	if len(c.string) < 3 {
+		return ""
+	}
+	return c.string[len(c.string)-3:]
+}
+
+// String gets the normalized course ID, e.g. "CIS1200001".
+func (c Course) String() string {
+	return c.string
+}
+
+// CourseStyle is an output format of course identifiers.
+type CourseStyle int
+
+const (
+	// StyleCompact formats as "CIS1200001", the form of CourseSectionStatus.SectionID.
+	StyleCompact CourseStyle = iota
+	// StyleDashed formats as "CIS-1200-001".
+	StyleDashed
+	// StyleSpaced formats as "CIS 1200 001".
+	StyleSpaced
+	// StylePathAtPenn formats as "CIS 1200 - 001".
+	StylePathAtPenn
+)
+
+// Format formats the course ID in the given style.
+func (c Course) Format(style CourseStyle) string {
+	switch style {
+	case StyleDashed:
+		return c.Subject() + "-" + c.Number() + "-" + c.Section()
+	case StyleSpaced:
+		return c.Subject() + " " + c.Number() + " " + c.Section()
+	case StylePathAtPenn:
+		return c.Subject() + " " + c.Number() + " - " + c.Section()
+	}
+	return c.string
+}
+
+// Set parses the course ID into c. It implements flag.Value.
+func (c *Course) Set(course string) error {
+	parsed := ParseCourse(course)
+	if parsed == nil {
+		return fmt.Errorf("%q: %w", course, ErrInvalidCourse)
+	}
+	*c = *parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Course) MarshalText() ([]byte, error) {
+	return []byte(c.string), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text results in a zero Course.
+func (c *Course) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = Course{}
+		return nil
+	}
+	return c.Set(string(text))
+}
+
+// MarshalJSON implements json.Marshaler. A zero Course is encoded as null.
+func (c Course) MarshalJSON() ([]byte, error) {
+	if c.string == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.string)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A null value results in a zero Course.
+func (c *Course) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = Course{}
+		return nil
+	}
+	var course string
+	if err := json.Unmarshal(data, &course); err != nil {
+		return err
+	}
+	return c.Set(course)
+}
+
+// Scan implements sql.Scanner. A NULL value results in a zero Course.
+func (c *Course) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*c = Course{}
+		return nil
+	case string:
+		return c.Set(src)
+	case []byte:
+		return c.Set(string(src))
+	}
+	return fmt.Errorf("cannot scan %T into Course", src)
+}
+
+// Value implements driver.Valuer. A zero Course is stored as NULL.
+func (c Course) Value() (driver.Value, error) {
+	if c.string == "" {
+		return nil, nil
+	}
+	return c.string, nil
 }
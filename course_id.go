@@ -0,0 +1,112 @@
+package opendata
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CourseID is a normalized struct for course ID without a section, e.g. "CIS1200".
+type CourseID struct{ string }
+
+// NewCourseID generates a new CourseID instance based on course subject and number.
+func NewCourseID(subject, number string) *CourseID {
+	subject = strings.ToUpper(strings.TrimSpace(subject))
+	number = strings.ToUpper(number)
+	if len(subject) > 4 || len(subject) <= 1 || !validCourse(number) {
+		return nil
+	}
+	return &CourseID{fmt.Sprintf("%s%04s", subject, number)}
+}
+
+var courseIDRegex = regexp.MustCompile(`^([a-zA-Z]{2,4})\s*-?\s*(\d{2,4}[abAB]?)$`)
+
+// ParseCourseID generates a new CourseID instance based on course ID string using regex to match.
+// It accepts catalog IDs such as the ones in CourseCatalogData.
+//
+//	ParseCourseID("CIS-1200")
+func ParseCourseID(id string, options ...ParseOption) *CourseID {
+	match := courseIDRegex.FindStringSubmatch(strings.TrimSpace(id))
+	if len(match) != 3 {
+		return nil
+	}
+	number, ok := newParseConfig(options).currentNumber(match[1], match[2])
+	if !ok {
+		return nil
+	}
+	return NewCourseID(match[1], number)
+}
+
+// Subject gets the subject of the course, e.g. "CIS".
+func (c CourseID) Subject() string {
+	return c.string[:subjectLen(c.string)]
+}
+
+// Number gets the four-digit course number, including any A/B suffix, e.g. "1200".
+func (c CourseID) Number() string {
+	return c.string[subjectLen(c.string):]
+}
+
+// Format formats the course ID in the given style.
+// StyleSpaced and StylePathAtPenn both format as "CIS 1200".
+func (c CourseID) Format(style CourseStyle) string {
+	switch style {
+	case StyleDashed:
+		return c.Subject() + "-" + c.Number()
+	case StyleSpaced, StylePathAtPenn:
+		return c.Subject() + " " + c.Number()
+	}
+	return c.string
+}
+
+// String gets the normalized course ID, e.g. "CIS1200".
+func (c CourseID) String() string {
+	return c.string
+}
+
+// Section generates the Course of the given section of this course.
+func (c CourseID) Section(section string) *Course {
+	return NewCourse(c.Subject(), c.Number(), section)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c CourseID) MarshalText() ([]byte, error) {
+	return []byte(c.string), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CourseID) UnmarshalText(text []byte) error {
+	id := ParseCourseID(string(text))
+	if id == nil {
+		return fmt.Errorf("%q: %w", text, ErrInvalidCourse)
+	}
+	*c = *id
+	return nil
+}
+
+// ID gets the parsed course ID of the catalog entry.
+func (c *CourseCatalogData) ID() *CourseID {
+	return ParseCourseID(c.CourseID)
+}
+
+// PrerequisiteIDs gets the parsed course IDs of the prerequisites, skipping unparsable ones.
+func (c *CourseCatalogData) PrerequisiteIDs() []*CourseID {
+	ret := make([]*CourseID, 0, len(c.Prerequisites))
+	for _, p := range c.Prerequisites {
+		if id := ParseCourseID(p.PrereqCourseId); id != nil {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+// CorequisiteIDs gets the parsed course IDs of the corequisites, skipping unparsable ones.
+func (c *CourseCatalogData) CorequisiteIDs() []*CourseID {
+	ret := make([]*CourseID, 0, len(c.Corequisites))
+	for _, p := range c.Corequisites {
+		if id := ParseCourseID(p.CoreqCourseId); id != nil {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,46 @@
+package opendata
+
+import "testing"
+
+func TestParseCourseIDCatalog(t *testing.T) {
+	id := ParseCourseID("CIS-1200")
+	if id == nil || id.String() != "CIS1200" || id.Subject() != "CIS" || id.Number() != "1200" {
+		t.Fatal(id)
+	}
+}
+
+func TestParseCourseIDSuffix(t *testing.T) {
+	id := ParseCourseID("crim 6004a")
+	if id == nil || id.String() != "CRIM6004A" {
+		t.Fatal(id)
+	}
+}
+
+func TestParseCourseIDInvalid(t *testing.T) {
+	if ParseCourseID("CIS-1200-001") != nil || ParseCourseID("C-1200") != nil {
+		t.Fail()
+	}
+}
+
+func TestCourseIDProjection(t *testing.T) {
+	course := ParseCourse("NETS-1120-001")
+	if course.CourseID().String() != "NETS1120" {
+		t.Fatal(course.CourseID())
+	}
+	if *course.CourseID().Section("001") != *course {
+		t.Fail()
+	}
+}
+
+func TestCatalogPrerequisiteIDs(t *testing.T) {
+	data := &CourseCatalogData{CourseID: "CIS-1210"}
+	data.Prerequisites = append(data.Prerequisites, struct {
+		PrereqCourseId string `json:"prereq_course_id"`
+	}{"CIS-1200"})
+	if data.ID().String() != "CIS1210" {
+		t.Fatal(data.ID())
+	}
+	if ids := data.PrerequisiteIDs(); len(ids) != 1 || ids[0].String() != "CIS1200" {
+		t.Fatal(ids)
+	}
+}
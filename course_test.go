@@ -1,6 +1,15 @@
 package opendata
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+	"testing/quick"
+)
 
 func TestParseCourseFullWidth(t *testing.T) {
 	course := ParseCourse("NETS1120001")
@@ -43,3 +52,101 @@ func TestParseCourseMultiTerm(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestCourseAccessors(t *testing.T) {
+	course := ParseCourse("CRIM-6004A-301")
+	if course.Subject() != "CRIM" || course.Number() != "6004A" || course.Section() != "301" || course.String() != "CRIM6004A301" {
+		t.Fatal(course.Subject(), course.Number(), course.Section())
+	}
+}
+
+func TestCourseJSON(t *testing.T) {
+	var value struct {
+		Course  Course  `json:"course"`
+		Missing *Course `json:"missing"`
+	}
+	if err := json.Unmarshal([]byte(`{"course":"CIS-1200-001","missing":null}`), &value); err != nil {
+		t.Fatal(err)
+	}
+	raw, err := json.Marshal(value)
+	if err != nil || string(raw) != `{"course":"CIS1200001","missing":null}` {
+		t.Fatal(string(raw), err)
+	}
+	if err := json.Unmarshal([]byte(`{"course":"CIS"}`), &value); !errors.Is(err, ErrInvalidCourse) {
+		t.Fatal(err)
+	}
+}
+
+func TestCourseZeroRoundTrip(t *testing.T) {
+	course := *ParseCourse("CIS1200001")
+	text, err := Course{}.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := course.UnmarshalText(text); err != nil || course != (Course{}) {
+		t.Fatal(course, err)
+	}
+	course = *ParseCourse("CIS1200001")
+	if err := json.Unmarshal([]byte("null"), &course); err != nil || course != (Course{}) {
+		t.Fatal(course, err)
+	}
+}
+
+func TestCourseSQLAndFlag(t *testing.T) {
+	var course Course
+	if err := course.Scan([]byte("NETS1120001")); err != nil || course.String() != "NETS1120001" {
+		t.Fatal(err)
+	}
+	if v, err := course.Value(); err != nil || v != "NETS1120001" {
+		t.Fatal(v, err)
+	}
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.Var(&course, "course", "course section")
+	if err := flags.Parse([]string{"-course", "CIS 1200-001"}); err != nil || course.String() != "CIS1200001" {
+		t.Fatal(err)
+	}
+}
+
+func TestParseCourseStudentStyle(t *testing.T) {
+	course := ParseCourse(" CIS 1200-001 ")
+	if course == nil || course.string != "CIS1200001" {
+		t.Fail()
+	}
+}
+
+// randomCourse generates arbitrary valid courses for property tests.
+type randomCourse struct{ *Course }
+
+func (randomCourse) Generate(rand *rand.Rand, size int) reflect.Value {
+	const letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	const alnum = letters + "0123456789"
+	subject := make([]byte, 2+rand.Intn(3))
+	for i := range subject {
+		subject[i] = letters[rand.Intn(len(letters))]
+	}
+	number := strconv.Itoa(1 + rand.Intn(9999))
+	if rand.Intn(4) == 0 {
+		number += string("AB"[rand.Intn(2)])
+	}
+	section := make([]byte, 3)
+	for i := range section {
+		section[i] = alnum[rand.Intn(len(alnum))]
+	}
+	return reflect.ValueOf(randomCourse{NewCourse(string(subject), number, string(section))})
+}
+
+func TestCourseFormatRoundTrip(t *testing.T) {
+	roundTrip := func(c randomCourse) bool {
+		for _, style := range []CourseStyle{StyleCompact, StyleDashed, StyleSpaced, StylePathAtPenn} {
+			parsed := ParseCourse(c.Format(style))
+			if parsed == nil || *parsed != *c.Course {
+				t.Logf("%s in style %d parsed as %v", c.Course, style, parsed)
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(roundTrip, &quick.Config{MaxCount: 2000}); err != nil {
+		t.Fatal(err)
+	}
+}
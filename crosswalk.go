@@ -0,0 +1,201 @@
+package opendata
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+//go:embed crosswalk.csv
+var defaultCrosswalkData string
+
+// Crosswalk maps legacy three-digit course numbers used before the 2022 renumbering
+// to their current four-digit equivalents and back.
+// The embedded table is not exhaustive; load or add mappings for the subjects you need,
+// and use WithStrictCrosswalk to detect legacy numbers missing from it.
+type Crosswalk struct {
+	lock      sync.RWMutex
+	toCurrent map[string]string
+	toLegacy  map[string]string
+}
+
+var (
+	defaultCrosswalk     *Crosswalk
+	defaultCrosswalkOnce sync.Once
+)
+
+// DefaultCrosswalk gets the crosswalk embedded in the package.
+// It is only a small hand-compiled sample of courses that has not been checked against
+// the registrar's published crosswalk; load the official table for historical joins.
+// Mappings added to it are visible to every user of the default crosswalk.
+func DefaultCrosswalk() *Crosswalk {
+	defaultCrosswalkOnce.Do(func() {
+		cw, err := LoadCrosswalk(strings.NewReader(defaultCrosswalkData))
+		if err != nil {
+			panic(err)
+		}
+		defaultCrosswalk = cw
+	})
+	return defaultCrosswalk
+}
+
+// NewCrosswalk generates an empty Crosswalk.
+func NewCrosswalk() *Crosswalk {
+	return &Crosswalk{toCurrent: make(map[string]string), toLegacy: make(map[string]string)}
+}
+
+// LoadCrosswalk reads a CSV table of legacy and current course IDs, e.g. "CIS120,CIS1200".
+// A header row starting with "legacy" is skipped.
+func LoadCrosswalk(r io.Reader) (*Crosswalk, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	cw := NewCrosswalk()
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return cw, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "legacy") {
+			continue
+		}
+		if err := cw.Add(record[0], record[1]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+// LoadCrosswalkFile reads a CSV crosswalk table from the file. See LoadCrosswalk.
+func LoadCrosswalkFile(path string) (*Crosswalk, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadCrosswalk(file)
+}
+
+// splitLegacy splits a legacy course ID such as "CIS 120" into its subject and three-digit number.
+func splitLegacy(legacy string) (string, string, bool) {
+	legacy = strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(legacy))
+	i := subjectLen(legacy)
+	subject, number := legacy[:i], legacy[i:]
+	if len(subject) < 2 || len(subject) > 4 || legacyDigits(number) != 3 || !validCourse(number) {
+		return "", "", false
+	}
+	return subject, number, true
+}
+
+// legacyDigits counts the digits of the number, excluding any A/B suffix.
+func legacyDigits(number string) int {
+	n := len(number)
+	if n > 0 && !(number[n-1] >= '0' && number[n-1] <= '9') {
+		n--
+	}
+	return n
+}
+
+// Add adds or replaces the mapping between a legacy and a current course ID.
+func (cw *Crosswalk) Add(legacy, current string) error {
+	subject, number, ok := splitLegacy(legacy)
+	if !ok {
+		return fmt.Errorf("legacy course %q: %w", legacy, ErrInvalidCourse)
+	}
+	id := ParseCourseID(current)
+	if id == nil {
+		return fmt.Errorf("current course %q: %w", current, ErrInvalidCourse)
+	}
+	cw.lock.Lock()
+	defer cw.lock.Unlock()
+	if old, ok := cw.toCurrent[subject+number]; ok && cw.toLegacy[old] == subject+number {
+		delete(cw.toLegacy, old)
+	}
+	cw.toCurrent[subject+number] = id.string
+	cw.toLegacy[id.string] = subject + number
+	return nil
+}
+
+// Current gets the current course ID of a legacy course ID such as "CIS120" or "CIS 120".
+func (cw *Crosswalk) Current(legacy string) (*CourseID, bool) {
+	subject, number, ok := splitLegacy(legacy)
+	if !ok {
+		return nil, false
+	}
+	cw.lock.RLock()
+	defer cw.lock.RUnlock()
+	current, ok := cw.toCurrent[subject+number]
+	if !ok {
+		return nil, false
+	}
+	return &CourseID{current}, true
+}
+
+// Legacy gets the legacy course ID, such as "CIS120", of a current course ID.
+func (cw *Crosswalk) Legacy(id *CourseID) (string, bool) {
+	cw.lock.RLock()
+	defer cw.lock.RUnlock()
+	legacy, ok := cw.toLegacy[id.string]
+	return legacy, ok
+}
+
+// LegacyCourse gets the legacy section ID, such as "CIS120001", of a current course section.
+func (cw *Crosswalk) LegacyCourse(course *Course) (string, bool) {
+	legacy, ok := cw.Legacy(course.CourseID())
+	if !ok {
+		return "", false
+	}
+	return legacy + course.Section(), true
+}
+
+// ParseOption configures ParseCourse and ParseCourseID.
+type ParseOption func(*parseConfig)
+
+type parseConfig struct {
+	crosswalk *Crosswalk
+	strict    bool
+}
+
+// WithCrosswalk maps three-digit legacy course numbers to their current equivalents while parsing.
+// Numbers missing from the crosswalk are zero-padded as usual.
+func WithCrosswalk(cw *Crosswalk) ParseOption {
+	return func(c *parseConfig) {
+		c.crosswalk = cw
+	}
+}
+
+// WithStrictCrosswalk is like WithCrosswalk, but parsing fails for three-digit legacy numbers
+// missing from the crosswalk instead of zero-padding them.
+func WithStrictCrosswalk(cw *Crosswalk) ParseOption {
+	return func(c *parseConfig) {
+		c.crosswalk = cw
+		c.strict = true
+	}
+}
+
+// currentNumber maps the number through the configured crosswalk, if any.
+// It returns false if a strict crosswalk has no mapping for the legacy number.
+func (c *parseConfig) currentNumber(subject, number string) (string, bool) {
+	if c.crosswalk == nil || legacyDigits(number) != 3 {
+		return number, true
+	}
+	if id, ok := c.crosswalk.Current(subject + number); ok {
+		return id.Number(), true
+	}
+	return number, !c.strict
+}
+
+func newParseConfig(options []ParseOption) *parseConfig {
+	config := new(parseConfig)
+	for _, option := range options {
+		option(config)
+	}
+	return config
+}
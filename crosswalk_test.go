@@ -0,0 +1,71 @@
+package opendata
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCourseCrosswalk(t *testing.T) {
+	course := ParseCourse("CIS120001", WithCrosswalk(DefaultCrosswalk()))
+	if course == nil || course.String() != "CIS1200001" {
+		t.Fatal(course)
+	}
+	if course := ParseCourse("CIS120001"); course == nil || course.String() != "CIS0120001" {
+		t.Fatal(course)
+	}
+}
+
+func TestParseCourseIDCrosswalkFallback(t *testing.T) {
+	id := ParseCourseID("ECON 001", WithCrosswalk(DefaultCrosswalk()))
+	if id == nil || id.String() != "ECON0100" {
+		t.Fatal(id)
+	}
+	if id := ParseCourseID("ABCD 123", WithCrosswalk(DefaultCrosswalk())); id == nil || id.String() != "ABCD0123" {
+		t.Fatal(id)
+	}
+}
+
+func TestParseCourseStrictCrosswalk(t *testing.T) {
+	strict := WithStrictCrosswalk(DefaultCrosswalk())
+	if course := ParseCourse("CIS120001", strict); course == nil || course.String() != "CIS1200001" {
+		t.Fatal(course)
+	}
+	if course := ParseCourse("CIS 261 001", strict); course != nil {
+		t.Fatal(course)
+	}
+	if id := ParseCourseID("CIS 261", strict); id != nil {
+		t.Fatal(id)
+	}
+	if id := ParseCourseID("CIS 2610", strict); id == nil || id.String() != "CIS2610" {
+		t.Fatal(id)
+	}
+}
+
+func TestCrosswalkReplace(t *testing.T) {
+	cw := NewCrosswalk()
+	if err := cw.Add("CIS120", "CIS1200"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cw.Add("CIS120", "CIS1201"); err != nil {
+		t.Fatal(err)
+	}
+	if legacy, ok := cw.Legacy(ParseCourseID("CIS1200")); ok {
+		t.Fatal(legacy)
+	}
+	if legacy, ok := cw.Legacy(ParseCourseID("CIS1201")); !ok || legacy != "CIS120" {
+		t.Fatal(legacy)
+	}
+}
+
+func TestCrosswalkLegacy(t *testing.T) {
+	cw, err := LoadCrosswalk(strings.NewReader("legacy,current\nMUSC 050, MUSC-0500\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if legacy, ok := cw.LegacyCourse(ParseCourse("MUSC0500003")); !ok || legacy != "MUSC050003" {
+		t.Fatal(legacy)
+	}
+	if _, err := LoadCrosswalk(strings.NewReader("CIS1200,CIS1200\n")); err == nil {
+		t.Fatal("four-digit legacy number accepted")
+	}
+}
@@ -0,0 +1,82 @@
+package opendata
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Cursor is a serializable position of a PageIterator, used to resume a crawl where it stopped.
+type Cursor struct {
+	// URL is the request URL without query parameters.
+	URL string `json:"url"`
+	// Parameters are the request query parameters, excluding the page number.
+	Parameters url.Values `json:"parameters,omitempty"`
+	// NextPage is the number of the next page to be fetched.
+	NextPage int `json:"next_page"`
+	// ResultsPerPage is the requested page size, or the page size reported by the last fetched page.
+	ResultsPerPage int `json:"results_per_page,omitempty"`
+	// Done reports whether the last page has been fetched.
+	Done bool `json:"done"`
+}
+
+// Cursor gets the current position of the iterator.
+// A failed page is not skipped, so resuming from the cursor fetches it again.
+// It returns nil if the iterator failed to be created.
+func (i *PageIterator[T]) Cursor() *Cursor {
+	if i.req == nil {
+		return nil
+	}
+	u := *i.req.URL
+	u.RawQuery = ""
+	parameters := i.req.URL.Query()
+	parameters.Del("page_number")
+	if len(parameters) == 0 {
+		parameters = nil
+	}
+	size := i.size
+	if size <= 0 {
+		size = i.data.ServiceMeta.ResultsPerPage
+	}
+	return &Cursor{
+		URL:            u.String(),
+		Parameters:     parameters,
+		NextPage:       i.cur,
+		ResultsPerPage: size,
+		Done:           i.end,
+	}
+}
+
+// ResumeIterator generates a PageIterator that continues from the cursor.
+// The cursor URL must belong to the base URL of the OpenData instance.
+func ResumeIterator[T any](od *OpenData, cursor *Cursor) *PageIterator[T] {
+	if !strings.HasPrefix(cursor.URL, od.baseURL) {
+		return newErrorIter[T](fmt.Errorf("cursor url %q is not under %q", cursor.URL, od.baseURL))
+	}
+	if cursor.NextPage <= 0 && !cursor.Done {
+		return newErrorIter[T](fmt.Errorf("invalid cursor page %d", cursor.NextPage))
+	}
+	req, err := http.NewRequest("GET", cursor.URL, nil)
+	if err != nil {
+		return newErrorIter[T](err)
+	}
+	req.URL.RawQuery = cursor.Parameters.Encode()
+	iter := newIter[T](od, req)
+	iter.cur = cursor.NextPage
+	iter.end = cursor.Done
+	if cursor.ResultsPerPage > 0 {
+		iter.size = cursor.ResultsPerPage
+	}
+	return iter
+}
+
+// ResumeCourseCatalog resumes a crawl started by Registrar.GetCourseCatalog.
+func (r *Registrar) ResumeCourseCatalog(cursor *Cursor) *PageIterator[CourseCatalogData] {
+	return ResumeIterator[CourseCatalogData](r.od, cursor)
+}
+
+// ResumeCourseSearch resumes a crawl started by Registrar.SearchCourseSection or Registrar.Search.
+func (r *Registrar) ResumeCourseSearch(cursor *Cursor) *PageIterator[CourseSearchData] {
+	return ResumeIterator[CourseSearchData](r.od, cursor)
+}
@@ -0,0 +1,72 @@
+package opendata
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCursorResume(t *testing.T) {
+	od := newTestAPI(t, pagedHandler(4))
+	r := od.GetRegistrar()
+	iter := r.SearchCourseSection(nil)
+	iter.NextPage()
+	iter.NextPage()
+
+	raw, err := json.Marshal(iter.Cursor())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := new(Cursor)
+	if err := json.Unmarshal(raw, cursor); err != nil {
+		t.Fatal(err)
+	}
+	if cursor.NextPage != 3 || cursor.Done {
+		t.Fatal(string(raw))
+	}
+
+	var ids []string
+	for result, err := range r.ResumeCourseSearch(cursor).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, result.SectionId)
+	}
+	if len(ids) != 4 {
+		t.Fatal(ids)
+	}
+}
+
+func TestCursorForeignURL(t *testing.T) {
+	od := newTestAPI(t, pagedHandler(1))
+	iter := od.GetRegistrar().ResumeCourseCatalog(&Cursor{URL: "https://example.com/api", NextPage: 1})
+	if iter.NextPage() || iter.GetError() == nil {
+		t.Fatal(fmt.Sprint(iter.GetError()))
+	}
+}
+
+func TestResultsPerPage(t *testing.T) {
+	handler := pagedHandler(2)
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("number_of_results_per_page") == "" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		handler(w, r)
+	}, WithPageSize(2))
+	iter := od.GetRegistrar().GetCourseCatalog("CIS", "")
+	if !iter.NextPage() || iter.GetError() != nil {
+		t.Fatal(iter.GetError())
+	}
+	if iter.Cursor().ResultsPerPage != 2 {
+		t.Fatal(iter.Cursor())
+	}
+	iter.SetResultsPerPage(50)
+	if !iter.NextPage() || iter.GetError() != nil {
+		t.Fatal(iter.GetError())
+	}
+	warnings := iter.Warnings()
+	if len(warnings) != 1 || warnings[0].Field != "results_per_page" || warnings[0].Expected != 50 || warnings[0].Actual != 2 {
+		t.Fatal(warnings)
+	}
+}
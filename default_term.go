@@ -0,0 +1,169 @@
+package opendata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MonthDay is a day of the year.
+type MonthDay struct {
+	Month time.Month
+	Day   int
+}
+
+// TermRules is the date-based rule set used to resolve default terms.
+type TermRules struct {
+	// RegistrationOpens is the day advance registration opens for a term of each season.
+	// A day after the start of the term refers to the previous calendar year.
+	RegistrationOpens map[Season]MonthDay
+	// Location is the time zone of the dates and of the current time, defaulting to UTC.
+	Location *time.Location
+}
+
+// DefaultTermRules approximates the Penn advance registration calendar in Philadelphia time.
+var DefaultTermRules = TermRules{
+	RegistrationOpens: map[Season]MonthDay{
+		Spring: {Month: time.October, Day: 25},
+		Summer: {Month: time.February, Day: 1},
+		Fall:   {Month: time.March, Day: 25},
+	},
+	Location: pennLocation(),
+}
+
+// pennLocation gets the America/New_York time zone,
+// falling back to Eastern Standard Time if the time zone database is unavailable.
+func pennLocation() *time.Location {
+	if loc, err := time.LoadLocation("America/New_York"); err == nil {
+		return loc
+	}
+	return time.FixedZone("EST", -5*60*60)
+}
+
+func (rules *TermRules) location() *time.Location {
+	if rules.Location == nil {
+		return time.UTC
+	}
+	return rules.Location
+}
+
+// registrationOpens gets the time registration for the term opens.
+func (rules *TermRules) registrationOpens(term Term) (time.Time, bool) {
+	day, ok := rules.RegistrationOpens[term.Season]
+	if !ok {
+		return time.Time{}, false
+	}
+	loc := rules.location()
+	opens := time.Date(term.Year, day.Month, day.Day, 0, 0, 0, 0, loc)
+	if opens.After(term.Start(loc)) {
+		opens = opens.AddDate(-1, 0, 0)
+	}
+	return opens, true
+}
+
+// WithTermRules sets the rules used by Registrar.RegistrationTerm.
+func WithTermRules(rules TermRules) RegistrarOption {
+	return func(r *Registrar) {
+		r.termRules = rules
+	}
+}
+
+// WithClock sets the function used to get the current time when resolving default terms.
+func WithClock(now func() time.Time) RegistrarOption {
+	return func(r *Registrar) {
+		r.now = now
+	}
+}
+
+// availableTerms gets the parsed available terms sorted from latest to earliest.
+func (r *Registrar) availableTerms(ctx context.Context) ([]Term, error) {
+	parameter, err := r.getParameterData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]Term, 0, len(parameter.AvailableTermsMap))
+	for code := range parameter.AvailableTermsMap {
+		if term, err := ParseTerm(code); err == nil {
+			terms = append(terms, term)
+		}
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		return terms[i].Compare(terms[j]) > 0
+	})
+	return terms, nil
+}
+
+// CurrentTerm gets the term in session, or the latest available term before it
+// if the term in session is not in the available term map.
+func (r *Registrar) CurrentTerm() (Term, error) {
+	return r.CurrentTermContext(context.Background())
+}
+
+// CurrentTermContext is like CurrentTerm but uses the given context.
+func (r *Registrar) CurrentTermContext(ctx context.Context) (Term, error) {
+	terms, err := r.availableTerms(ctx)
+	if err != nil {
+		return Term{}, err
+	}
+	current := TermAt(r.now().In(r.termRules.location()))
+	for _, term := range terms {
+		if term.Compare(current) <= 0 {
+			return term, nil
+		}
+	}
+	return Term{}, fmt.Errorf("no term available at or before %s: %w", current, ErrUnknownTerm)
+}
+
+// RegistrationTerm gets the latest available term after the current one whose registration has opened
+// according to the term rules, or the current term if there is none.
+func (r *Registrar) RegistrationTerm() (Term, error) {
+	return r.RegistrationTermContext(context.Background())
+}
+
+// RegistrationTermContext is like RegistrationTerm but uses the given context.
+func (r *Registrar) RegistrationTermContext(ctx context.Context) (Term, error) {
+	terms, err := r.availableTerms(ctx)
+	if err != nil {
+		return Term{}, err
+	}
+	now := r.now().In(r.termRules.location())
+	current := TermAt(now)
+	for _, term := range terms {
+		if term.Compare(current) <= 0 {
+			break
+		}
+		if opens, ok := r.termRules.registrationOpens(term); ok && !now.Before(opens) {
+			return term, nil
+		}
+	}
+	return r.CurrentTermContext(ctx)
+}
+
+// GetCourseStatusDefault is like GetCourseStatus but uses the term given by RegistrationTerm.
+func (r *Registrar) GetCourseStatusDefault(course *Course) ([]CourseSectionStatus, error) {
+	return r.GetCourseStatusDefaultContext(context.Background(), course)
+}
+
+// GetCourseStatusDefaultContext is like GetCourseStatusDefault but uses the given context.
+func (r *Registrar) GetCourseStatusDefaultContext(ctx context.Context, course *Course) ([]CourseSectionStatus, error) {
+	term, err := r.RegistrationTermContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetCourseStatusContext(ctx, term.Code(), course)
+}
+
+// GetAllCourseStatusDefault is like GetAllCourseStatus but uses the term given by RegistrationTerm.
+func (r *Registrar) GetAllCourseStatusDefault() ([]CourseSectionStatus, error) {
+	return r.GetAllCourseStatusDefaultContext(context.Background())
+}
+
+// GetAllCourseStatusDefaultContext is like GetAllCourseStatusDefault but uses the given context.
+func (r *Registrar) GetAllCourseStatusDefaultContext(ctx context.Context) ([]CourseSectionStatus, error) {
+	term, err := r.RegistrationTermContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetAllCourseStatusContext(ctx, term.Code())
+}
@@ -0,0 +1,54 @@
+package opendata
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTermTestRegistrar(t *testing.T, date string) *Registrar {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result_data":[{"available_terms_map":{"202310":"","202320":"","202330":""}}],"service_meta":{}}`))
+	})
+	now, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return od.GetRegistrar(WithClock(func() time.Time { return now }))
+}
+
+func TestRegistrationTerm(t *testing.T) {
+	cases := map[string]string{
+		"2023-01-20": "202310",
+		"2023-02-10": "202320",
+		"2023-04-01": "202330",
+		"2023-12-01": "202330",
+	}
+	for date, code := range cases {
+		term, err := newTermTestRegistrar(t, date).RegistrationTerm()
+		if err != nil || term.Code() != code {
+			t.Errorf("%s: got %s, want %s, err %v", date, term.Code(), code, err)
+		}
+	}
+}
+
+func TestCurrentTermFallback(t *testing.T) {
+	term, err := newTermTestRegistrar(t, "2024-02-01").CurrentTerm()
+	if err != nil || term.Code() != "202330" {
+		t.Fatal(term, err)
+	}
+}
+
+func TestRegistrationTermLocation(t *testing.T) {
+	// 00:30 UTC on March 25 is still March 24 in Philadelphia, before fall registration opens.
+	r := newTermTestRegistrar(t, "2023-03-25")
+	now := r.now().Add(30 * time.Minute)
+	r.now = func() time.Time { return now }
+	if term, err := r.RegistrationTerm(); err != nil || term.Code() != "202320" {
+		t.Fatal(term, err)
+	}
+	r.termRules.Location = time.UTC
+	if term, err := r.RegistrationTerm(); err != nil || term.Code() != "202330" {
+		t.Fatal(term, err)
+	}
+}
@@ -0,0 +1,98 @@
+package opendata
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+var (
+	// ErrUnknownTerm is returned when a term is not in the available term map.
+	ErrUnknownTerm = errors.New("unknown term")
+	// ErrInvalidTerm is returned when a term code cannot be parsed.
+	ErrInvalidTerm = errors.New("invalid term")
+	// ErrUnsupportedParameter is returned when a search parameter is not in the acceptable search url parameters map.
+	ErrUnsupportedParameter = errors.New("unsupported parameter")
+	// ErrUnauthorized matches an *APIError caused by rejected or missing credentials.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited matches an *APIError caused by exceeding the gateway quota.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrNotFound matches an *APIError caused by a missing resource.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidCourse is returned when a course ID cannot be parsed.
+	ErrInvalidCourse = errors.New("invalid course")
+	// ErrUnknownSubject is returned when a course subject is not in the subject map.
+	ErrUnknownSubject = errors.New("unknown subject")
+	// ErrSectionNotFound is returned when a course section does not exist in a term.
+	ErrSectionNotFound = errors.New("section not found")
+	// ErrWatcherStarted is returned by Watcher.Run when the Watcher has already been run.
+	ErrWatcherStarted = errors.New("watcher already started")
+)
+
+// APIError is the error returned when OpenData responds with an HTTP error or a service_meta error.
+// Use errors.Is with ErrUnauthorized, ErrRateLimited or ErrNotFound to branch on the cause.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// RestCode is the rest_code reported in service_meta, if any.
+	RestCode int
+	// Text is the unescaped error_text reported in service_meta, or the HTTP status text.
+	Text string
+	// URL is the request URL.
+	URL string
+	// Err is the underlying error, such as an *oauth2.RetrieveError for token requests.
+	Err error
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("opendata: %s (status %d, rest code %d) for %s", e.Text, e.StatusCode, e.RestCode, e.URL)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// tokenError converts a failed token request into an *APIError so that bad credentials match ErrUnauthorized.
+func tokenError(err error, tokenURL string) error {
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) || retrieveErr.Response == nil {
+		return err
+	}
+	apiErr := &APIError{
+		StatusCode: retrieveErr.Response.StatusCode,
+		Text:       http.StatusText(retrieveErr.Response.StatusCode),
+		URL:        tokenURL,
+		Err:        err,
+	}
+	if retrieveErr.Response.Request != nil {
+		apiErr.URL = retrieveErr.Response.Request.URL.String()
+	}
+	if body := strings.TrimSpace(string(retrieveErr.Body)); body != "" {
+		apiErr.Text = "token request failed: " + body
+	}
+	return apiErr
+}
+
+// code gets the most specific status code, preferring the HTTP status over the rest code.
+func (e *APIError) code() int {
+	if e.StatusCode >= 200 && e.StatusCode < 300 && e.RestCode != 0 {
+		return e.RestCode
+	}
+	return e.StatusCode
+}
+
+// Is reports whether the error matches one of the sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.code() == http.StatusUnauthorized || e.code() == http.StatusForbidden
+	case ErrRateLimited:
+		return e.code() == http.StatusTooManyRequests
+	case ErrNotFound:
+		return e.code() == http.StatusNotFound
+	}
+	return false
+}
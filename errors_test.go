@@ -0,0 +1,75 @@
+package opendata
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+const testParameters = `{"result_data":[{"available_terms_map":{"202230":"Fall 2022"},"acceptable_search_url_parameters_map":{"term":"Term"}}],"service_meta":{}}`
+
+func TestAPIErrorHTTPStatus(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	_, err := od.GetRegistrar().GetAvailableTermMap()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatal(err)
+	}
+	if !errors.Is(err, ErrRateLimited) || errors.Is(err, ErrNotFound) {
+		t.Fatal(err)
+	}
+}
+
+func TestAPIErrorServiceMeta(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result_data":[],"service_meta":{"error":true,"error_text":"Course &quot;X&quot; not found","rest_code":404}}`))
+	})
+	iter := od.GetRegistrar().GetCourseCatalog("X", "")
+	if !iter.NextPage() {
+		t.FailNow()
+	}
+	var apiErr *APIError
+	if !errors.As(iter.GetError(), &apiErr) || apiErr.Text != `Course "X" not found` {
+		t.Fatal(iter.GetError())
+	}
+	if !errors.Is(iter.GetError(), ErrNotFound) {
+		t.Fatal(iter.GetError())
+	}
+}
+
+func TestUnknownTerm(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testParameters))
+	})
+	_, err := od.GetRegistrar().GetAllCourseStatus("209910")
+	if !errors.Is(err, ErrUnknownTerm) {
+		t.Fatal(err)
+	}
+}
+
+func TestTokenUnauthorized(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client","error_description":"Invalid client credentials"}`))
+	}))
+	defer tokenServer.Close()
+	od := NewOpenDataAPI("id", "wrong", WithBaseURL(tokenServer.URL), WithTokenURL(tokenServer.URL+"/token"))
+	_, err := od.GetRegistrar().GetAvailableTermMap()
+	var apiErr *APIError
+	if !errors.Is(err, ErrUnauthorized) || !errors.As(err, &apiErr) {
+		t.Fatal(err)
+	}
+	if apiErr.StatusCode != http.StatusUnauthorized || apiErr.URL != tokenServer.URL+"/token" {
+		t.Fatal(apiErr)
+	}
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) {
+		t.Fatal(err)
+	}
+}
@@ -3,41 +3,220 @@ package opendata
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"html"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 )
 
-const openDataURL = `https://3scale-public-prod-open-data.apps.k8s.upenn.edu/api/v1/`
+const (
+	openDataURL = `https://3scale-public-prod-open-data.apps.k8s.upenn.edu/api/v1/`
+	tokenURL    = `https://sso.apps.k8s.upenn.edu/auth/realms/master/protocol/openid-connect/token`
+)
+
+// Endpoint names accepted by per-endpoint options.
+const (
+	EndpointSearchParameters = "course_section_search_parameters"
+	EndpointCourseStatus     = "course_section_status"
+	EndpointCourseCatalog    = "course_info"
+	EndpointCourseSearch     = "course_section_search"
+)
 
-// OpenData is a struct that stores OpenData API username and password.
+// OpenData is a struct that stores OpenData API credentials and client configuration.
 type OpenData struct {
-	client *http.Client
+	baseURL     string
+	tokenURL    string
+	userAgent   string
+	httpClient  *http.Client
+	tokenSource oauth2.TokenSource
+	config      *clientcredentials.Config
+	token       *oauth2.Token
+	tokenLock   sync.Mutex
+	retry       RetryPolicy
+	pageSize    int
+	schema      *SchemaReport
+
+	limiter          *limiter
+	endpointLimiters map[string]*limiter
+
+	cache         Cache
+	cacheDefault  CachePolicy
+	cachePolicies map[string]CachePolicy
+	revalidating  sync.Map
 }
 
 // NewOpenDataAPI generates an instance of OpenData
-// with specific username and password.
-func NewOpenDataAPI(clientId, clientSecret string) *OpenData {
-	return &OpenData{client: (&clientcredentials.Config{
-		ClientID:     clientId,
-		ClientSecret: clientSecret,
-		TokenURL:     "https://sso.apps.k8s.upenn.edu/auth/realms/master/protocol/openid-connect/token",
-		AuthStyle:    oauth2.AuthStyleInHeader,
-	}).Client(context.TODO())}
+// with specific client ID and client secret.
+// Options can be supplied to override the endpoints, HTTP client or token source.
+func NewOpenDataAPI(clientId, clientSecret string, options ...Option) *OpenData {
+	o := &OpenData{
+		baseURL:    openDataURL,
+		tokenURL:   tokenURL,
+		httpClient: http.DefaultClient,
+	}
+	for _, option := range options {
+		option(o)
+	}
+	if o.tokenSource != nil {
+		o.tokenSource = oauth2.ReuseTokenSource(nil, o.tokenSource)
+	} else {
+		o.config = &clientcredentials.Config{
+			ClientID:     clientId,
+			ClientSecret: clientSecret,
+			TokenURL:     o.tokenURL,
+			AuthStyle:    oauth2.AuthStyleInHeader,
+		}
+	}
+	return o
 }
 
-func (o *OpenData) access(req *http.Request) (*http.Response, error) {
+func (o *OpenData) url(path string) string {
+	return o.baseURL + path
+}
+
+// endpoint gets the endpoint name of the request URL, which is the first path segment after the base URL.
+func (o *OpenData) endpoint(u *url.URL) string {
+	path := strings.TrimPrefix(u.String(), o.baseURL)
+	if i := strings.IndexAny(path, "/?"); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// getToken returns a valid access token, refreshing it with the given context if needed.
+func (o *OpenData) getToken(ctx context.Context) (*oauth2.Token, error) {
+	if o.tokenSource != nil {
+		token, err := o.tokenSource.Token()
+		if err != nil {
+			return nil, tokenError(err, o.tokenURL)
+		}
+		return token, nil
+	}
+	o.tokenLock.Lock()
+	defer o.tokenLock.Unlock()
+	if o.token.Valid() {
+		return o.token, nil
+	}
+	token, err := o.config.Token(context.WithValue(ctx, oauth2.HTTPClient, o.httpClient))
+	if err != nil {
+		return nil, tokenError(err, o.tokenURL)
+	}
+	o.token = token
+	return token, nil
+}
+
+func (o *OpenData) access(ctx context.Context, req *http.Request) (*http.Response, error) {
+	token, err := o.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	token.SetAuthHeader(req)
 	req.Header.Set("Accept", "application/json; charset=utf-8")
-	return o.client.Do(req)
+	if o.userAgent != "" {
+		req.Header.Set("User-Agent", o.userAgent)
+	}
+
+	endpoint := o.endpoint(req.URL)
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+	for attempt := 1; ; attempt++ {
+		if err := o.waitLimit(ctx, endpoint); err != nil {
+			return nil, err
+		}
+		resp, err := o.httpClient.Do(req)
+		if !idempotent || attempt >= o.retry.MaxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+		if err == nil && !retryableStatus(resp.StatusCode) || err != nil && !IsRetryable(err) {
+			return resp, err
+		}
+
+		event := RetryEvent{Attempt: attempt, URL: req.URL.String(), Err: err}
+		if resp != nil {
+			event.StatusCode = resp.StatusCode
+		}
+		event.Wait = o.retry.backoff(attempt, resp)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if o.retry.OnRetry != nil {
+			o.retry.OnRetry(event)
+		}
+		if err := sleepContext(ctx, event.Wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// get sends the request and decodes the response into v, consulting the cache if one is configured.
+// HTTP and service errors are reported as *APIError.
+func (o *OpenData) get(ctx context.Context, req *http.Request, v *data) error {
+	if policy, ok := o.cachePolicy(req); ok {
+		return o.cachedGet(ctx, req, v, policy)
+	}
+	_, err := o.fetch(ctx, req, v)
+	return err
+}
+
+// fetch sends the request, decodes the response into v and returns the raw body.
+func (o *OpenData) fetch(ctx context.Context, req *http.Request, v *data) ([]byte, error) {
+	resp, err := o.access(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return body, decodeResponse(resp, body, v)
+}
+
+func decodeResponse(resp *http.Response, body []byte, v *data) error {
+	err := json.Unmarshal(body, v)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			Text:       http.StatusText(resp.StatusCode),
+			URL:        resp.Request.URL.String(),
+		}
+		if err == nil {
+			apiErr.RestCode = v.ServiceMeta.RestCode
+			if v.ServiceMeta.ErrorText != "" {
+				apiErr.Text = html.UnescapeString(v.ServiceMeta.ErrorText)
+			}
+		}
+		return apiErr
+	}
+	if err != nil {
+		return err
+	}
+	if v.ServiceMeta.Error {
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			RestCode:   v.ServiceMeta.RestCode,
+			Text:       html.UnescapeString(v.ServiceMeta.ErrorText),
+			URL:        resp.Request.URL.String(),
+		}
+	}
+	return nil
 }
 
 // GetRegistrar generates a Registrar instance using the current OpenData instance.
-func (o *OpenData) GetRegistrar() *Registrar {
-	return &Registrar{od: o}
+// Every Registrar shares the rate limit and cache of the OpenData instance.
+func (o *OpenData) GetRegistrar(options ...RegistrarOption) *Registrar {
+	r := &Registrar{od: o, parameterTTL: DefaultParameterTTL, termRules: DefaultTermRules, now: time.Now}
+	for _, option := range options {
+		option(r)
+	}
+	return r
 }
 
 // PageIterator provides an iterator for paging function.
@@ -48,6 +227,18 @@ type PageIterator[T any] struct {
 	req  *http.Request
 	data *data
 	cur  int
+	size int
+
+	workers  int
+	prefetch *prefetcher
+
+	consistency ConsistencyPolicy
+	restarts    int
+	sizeWarned  int
+	baseline    *serviceMeta
+	warnings    []*ConsistencyWarning
+	key         func(*T) string
+	seen        map[string]struct{}
 }
 
 func newErrorIter[T any](err error) *PageIterator[T] {
@@ -57,7 +248,7 @@ func newErrorIter[T any](err error) *PageIterator[T] {
 }
 
 func newIter[T any](od *OpenData, req *http.Request) *PageIterator[T] {
-	iter := &PageIterator[T]{od: od, req: req, cur: 1}
+	iter := &PageIterator[T]{od: od, req: req, cur: 1, size: od.pageSize}
 	iter.data = new(data)
 	return iter
 }
@@ -66,38 +257,63 @@ func newIter[T any](od *OpenData, req *http.Request) *PageIterator[T] {
 // If the return value if true then a new page is successfully obtained, or an error has occurred.
 // Otherwise, the end of the result is reached.
 func (i *PageIterator[T]) NextPage() bool {
+	return i.NextPageContext(context.Background())
+}
+
+// NextPageContext is like NextPage but uses the given context for the page request.
+func (i *PageIterator[T]) NextPageContext(ctx context.Context) bool {
 	if i.end {
 		return false
 	}
 
-	query := i.req.URL.Query()
-	query.Set("page_number", strconv.Itoa(i.cur))
-	i.req.URL.RawQuery = query.Encode()
-
-	resp, err := i.od.access(i.req)
-	if err != nil {
-		i.err = err
-		return true
+	data, err := i.takePrefetched(ctx, i.cur)
+	if data == nil && err == nil {
+		data, err = i.fetchPage(ctx, i.cur)
 	}
-	defer resp.Body.Close()
-
-	if err := json.NewDecoder(resp.Body).Decode(i.data); err != nil {
-		i.err = err
-		return true
+	if err == nil && i.checkConsistency(data) {
+		i.Close()
+		i.cur = 1
+		data, err = i.fetchPage(ctx, i.cur)
+		if err == nil {
+			i.checkConsistency(data)
+		}
 	}
-
-	if i.data.ServiceMeta.Error {
-		i.err = errors.New(html.UnescapeString(i.data.ServiceMeta.ErrorText))
+	if err != nil {
+		i.Close()
+		i.err = err
 		return true
 	}
 
+	i.checkPageSize(data)
+	i.dedupe(data)
+	i.data = data
 	i.cur = i.data.ServiceMeta.NextPageNumber
 	i.end = i.data.ServiceMeta.NumberOfPages == i.data.ServiceMeta.CurrentPageNumber
 	i.err = nil
 
+	if i.workers > 0 && i.prefetch == nil && !i.end {
+		i.startPrefetch(ctx)
+	}
 	return true
 }
 
+// fetchPage fetches the given page without modifying the iterator.
+func (i *PageIterator[T]) fetchPage(ctx context.Context, page int) (*data, error) {
+	req := i.req.Clone(ctx)
+	query := req.URL.Query()
+	query.Set("page_number", strconv.Itoa(page))
+	if i.size > 0 {
+		query.Set("number_of_results_per_page", strconv.Itoa(i.size))
+	}
+	req.URL.RawQuery = query.Encode()
+
+	data := new(data)
+	if err := i.od.get(ctx, req, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
 // GetError gets the latest error generated.
 func (i *PageIterator[T]) GetError() error {
 	return i.err
@@ -110,10 +326,19 @@ func (i *PageIterator[T]) GetResult(index int) (*T, error) {
 		return nil, i.err
 	}
 	ret := new(T)
-	err := json.Unmarshal(i.data.ResultData[index], ret)
+	err := i.od.unmarshal(i.data.ResultData[index], ret)
 	return ret, err
 }
 
+// SetResultsPerPage sets the number of results requested for every following page,
+// overriding the client default. A non-positive size uses the server default.
+// A page that does not report the requested size, e.g. because the server clamps it,
+// records a ConsistencyWarning for the results_per_page field.
+func (i *PageIterator[T]) SetResultsPerPage(size int) *PageIterator[T] {
+	i.size = size
+	return i
+}
+
 // GetPageSize gets the current size of the page.
 func (i *PageIterator[T]) GetPageSize() int {
 	return len(i.data.ResultData)
@@ -0,0 +1,59 @@
+package opendata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func newTestAPI(t *testing.T, handler http.HandlerFunc, options ...Option) *OpenData {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	options = append([]Option{
+		WithBaseURL(server.URL + "/api/v1"),
+		WithHTTPClient(server.Client()),
+		WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"})),
+	}, options...)
+	return NewOpenDataAPI("", "", options...)
+}
+
+func TestOptionsBaseURLAndHeaders(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/course_section_search_parameters" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get("User-Agent") != "opendata-test" {
+			t.Errorf("unexpected user agent %q", r.Header.Get("User-Agent"))
+		}
+		w.Write([]byte(`{"result_data":[{"available_terms_map":{"202230":"Fall 2022"}}],"service_meta":{}}`))
+	}, WithUserAgent("opendata-test"))
+	terms, err := od.GetRegistrar().GetAvailableTermMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if terms["202230"] != "Fall 2022" {
+		t.Fail()
+	}
+}
+
+func TestNextPageContextCanceled(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	iter := od.GetRegistrar().GetCourseCatalog("NETS", "")
+	if !iter.NextPageContext(ctx) {
+		t.FailNow()
+	}
+	if !errors.Is(iter.GetError(), context.Canceled) {
+		t.Fatal(iter.GetError())
+	}
+}
@@ -0,0 +1,60 @@
+package opendata
+
+import (
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// Option configures an OpenData instance created by NewOpenDataAPI.
+type Option func(*OpenData)
+
+// WithBaseURL overrides the OpenData API endpoint,
+// e.g. to point the client at a staging gateway or a local fake.
+func WithBaseURL(baseURL string) Option {
+	return func(o *OpenData) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		o.baseURL = baseURL
+	}
+}
+
+// WithTokenURL overrides the OAuth2 token endpoint used for the client credentials flow.
+func WithTokenURL(tokenURL string) Option {
+	return func(o *OpenData) {
+		o.tokenURL = tokenURL
+	}
+}
+
+// WithHTTPClient sets the underlying HTTP client used for both API and token requests.
+// Its transport, timeout, cookie jar and redirect policy are preserved.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *OpenData) {
+		o.httpClient = client
+	}
+}
+
+// WithTokenSource injects a token source to be used instead of the client credentials flow.
+// The client ID, client secret and token URL are ignored when a token source is provided.
+func WithTokenSource(source oauth2.TokenSource) Option {
+	return func(o *OpenData) {
+		o.tokenSource = source
+	}
+}
+
+// WithPageSize sets the default number of results per page of every PageIterator.
+// A non-positive size uses the server default.
+func WithPageSize(size int) Option {
+	return func(o *OpenData) {
+		o.pageSize = size
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every API request.
+func WithUserAgent(userAgent string) Option {
+	return func(o *OpenData) {
+		o.userAgent = userAgent
+	}
+}
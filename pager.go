@@ -0,0 +1,143 @@
+package opendata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+)
+
+// PageError is the error reported by Pager when a page fails.
+type PageError struct {
+	// Page is the number of the failed page, or 0 if the iterator could not be created.
+	Page int
+	// Err is the underlying error.
+	Err error
+	// Retryable reports whether fetching the page again may succeed.
+	Retryable bool
+}
+
+func (e *PageError) Error() string {
+	return fmt.Sprintf("page %d: %v", e.Page, e.Err)
+}
+
+func (e *PageError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryable reports whether the error is transient, i.e. a timeout, a reset or refused connection,
+// a truncated response, a rate limit or a temporary server failure.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var pageErr *PageError
+	if errors.As(err, &pageErr) {
+		return pageErr.Retryable
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return retryableStatus(apiErr.code())
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Pager wraps a PageIterator with bufio.Scanner-like semantics:
+// Next returns false at the end of the results or on error, Err reports the error,
+// and a failed page is only fetched again by an explicit call to Retry.
+//
+//	pager := registrar.GetCourseCatalog("CIS", "").Pager()
+//	for pager.Next() {
+//		for _, course := range pager.Page() {
+//			...
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	iter *PageIterator[T]
+	page []T
+	err  *PageError
+}
+
+// Pager generates a Pager that continues from the current position of the iterator.
+// If the iterator prefetches, call Close when stopping before the end of the results.
+func (i *PageIterator[T]) Pager() *Pager[T] {
+	return &Pager[T]{iter: i}
+}
+
+// Next fetches the next page. It returns false at the end of the results or when an error occurs.
+func (p *Pager[T]) Next() bool {
+	return p.NextContext(context.Background())
+}
+
+// NextContext is like Next but uses the given context for the page request.
+func (p *Pager[T]) NextContext(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	p.page = nil
+	page := p.iter.cur
+	if !p.iter.NextPageContext(ctx) {
+		if p.iter.err != nil {
+			p.err = &PageError{Err: p.iter.err}
+		}
+		return false
+	}
+	if p.iter.err != nil {
+		p.err = &PageError{Page: page, Err: p.iter.err, Retryable: IsRetryable(p.iter.err)}
+		return false
+	}
+	results := make([]T, p.iter.GetPageSize())
+	for j := range results {
+		result, err := p.iter.GetResult(j)
+		if err != nil {
+			p.err = &PageError{Page: page, Err: err}
+			return false
+		}
+		results[j] = *result
+	}
+	p.page = results
+	return true
+}
+
+// Page gets the results of the current page.
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// Err gets the error that stopped the pager, as a *PageError, or nil at the end of the results.
+func (p *Pager[T]) Err() error {
+	if p.err == nil {
+		return nil
+	}
+	return p.err
+}
+
+// Retry fetches the failed page again if its error is retryable.
+// It returns false if there is nothing to retry or the page fails again.
+func (p *Pager[T]) Retry() bool {
+	return p.RetryContext(context.Background())
+}
+
+// RetryContext is like Retry but uses the given context for the page request.
+func (p *Pager[T]) RetryContext(ctx context.Context) bool {
+	if p.err == nil || !p.err.Retryable {
+		return false
+	}
+	p.err = nil
+	return p.NextContext(ctx)
+}
+
+// Close stops any ongoing prefetching of the underlying iterator.
+func (p *Pager[T]) Close() {
+	p.iter.Close()
+}
@@ -0,0 +1,82 @@
+package opendata
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"syscall"
+	"testing"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestPagerRetryable(t *testing.T) {
+	handler := pagedHandler(3)
+	failures := 1
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_number") == "2" && failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		handler(w, r)
+	})
+	pager := od.GetRegistrar().GetCourseCatalog("CIS", "").Pager()
+	pages := 0
+	for pager.Next() {
+		pages++
+	}
+	var pageErr *PageError
+	if pages != 1 || !errors.As(pager.Err(), &pageErr) || pageErr.Page != 2 || !pageErr.Retryable {
+		t.Fatalf("pages = %d, err = %v", pages, pager.Err())
+	}
+	if pager.Next() {
+		t.Fatal("Next should not retry implicitly")
+	}
+	if !pager.Retry() {
+		t.Fatal(pager.Err())
+	}
+	for pages = 2; pager.Next(); pages++ {
+	}
+	if pages != 3 || pager.Err() != nil {
+		t.Fatalf("pages = %d, err = %v", pages, pager.Err())
+	}
+}
+
+func TestPagerFatal(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	pager := od.GetRegistrar().GetCourseCatalog("CIS", "").Pager()
+	if pager.Next() || IsRetryable(pager.Err()) || pager.Retry() {
+		t.Fatal(pager.Err())
+	}
+	if !errors.Is(pager.Err(), ErrNotFound) {
+		t.Fatal(pager.Err())
+	}
+}
+
+func TestIsRetryableTransportErrors(t *testing.T) {
+	for _, test := range []struct {
+		err  error
+		want bool
+	}{
+		{fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{errors.New("tls: failed to verify certificate"), false},
+	} {
+		od := newTestAPI(t, nil, WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				return nil, test.err
+			}),
+		}))
+		pager := od.GetRegistrar().GetCourseCatalog("CIS", "").Pager()
+		if pager.Next() || IsRetryable(pager.Err()) != test.want {
+			t.Errorf("IsRetryable(%v) != %v", pager.Err(), test.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package opendata
+
+import (
+	"context"
+	"sort"
+	"strconv"
+)
+
+// SearchParameters provides the maps published by the course section search parameters service.
+// See https://app.swaggerhub.com/apis-docs/UPennISC/open-data/prod#/Course%20section%20search%20service.
+type SearchParameters struct {
+	parameter *courseSectionSearchParameters
+}
+
+// Choice is a code and its description in one of the search parameter maps.
+type Choice struct {
+	Code        string
+	Description string
+}
+
+// GetSearchParameters gets the search parameters provided by OpenData API.
+func (r *Registrar) GetSearchParameters() (*SearchParameters, error) {
+	return r.GetSearchParametersContext(context.Background())
+}
+
+// GetSearchParametersContext is like GetSearchParameters but uses the given context.
+func (r *Registrar) GetSearchParametersContext(ctx context.Context) (*SearchParameters, error) {
+	parameter, err := r.getParameterData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchParameters{parameter: parameter}, nil
+}
+
+func copyMap(m map[string]string) map[string]string {
+	ret := make(map[string]string, len(m))
+	for k, v := range m {
+		ret[k] = v
+	}
+	return ret
+}
+
+// sortedChoices sorts the map by code, comparing numeric codes by value.
+func sortedChoices(m map[string]string) []Choice {
+	ret := make([]Choice, 0, len(m))
+	for k, v := range m {
+		ret = append(ret, Choice{Code: k, Description: v})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		a, errA := strconv.Atoi(ret[i].Code)
+		b, errB := strconv.Atoi(ret[j].Code)
+		if errA == nil && errB == nil && a != b {
+			return a < b
+		}
+		return ret[i].Code < ret[j].Code
+	})
+	return ret
+}
+
+// AcceptableSearchURLParameters gets a copy of the acceptable search url parameters map.
+func (s *SearchParameters) AcceptableSearchURLParameters() map[string]string {
+	return copyMap(s.parameter.AcceptableSearchURLParametersMap)
+}
+
+// Terms gets a copy of the available terms map.
+func (s *SearchParameters) Terms() map[string]string {
+	return copyMap(s.parameter.AvailableTermsMap)
+}
+
+// SortedTerms gets the available terms sorted by term code.
+func (s *SearchParameters) SortedTerms() []Choice {
+	return sortedChoices(s.parameter.AvailableTermsMap)
+}
+
+// Activities gets a copy of the activity map.
+func (s *SearchParameters) Activities() map[string]string {
+	return copyMap(s.parameter.ActivityMap)
+}
+
+// SortedActivities gets the activities sorted by activity code.
+func (s *SearchParameters) SortedActivities() []Choice {
+	return sortedChoices(s.parameter.ActivityMap)
+}
+
+// Subjects gets a copy of the subject map.
+func (s *SearchParameters) Subjects() map[string]string {
+	return copyMap(s.parameter.SubjectMap)
+}
+
+// SortedSubjects gets the subjects sorted by subject code.
+func (s *SearchParameters) SortedSubjects() []Choice {
+	return sortedChoices(s.parameter.SubjectMap)
+}
+
+// CourseLevelsAtOrAbove gets a copy of the course level at or above map.
+func (s *SearchParameters) CourseLevelsAtOrAbove() map[string]string {
+	return copyMap(s.parameter.CourseLevelAtOrAboveMap)
+}
+
+// SortedCourseLevelsAtOrAbove gets the course levels at or above sorted by level.
+func (s *SearchParameters) SortedCourseLevelsAtOrAbove() []Choice {
+	return sortedChoices(s.parameter.CourseLevelAtOrAboveMap)
+}
+
+// CourseLevelsAtOrBelow gets a copy of the course level at or below map.
+func (s *SearchParameters) CourseLevelsAtOrBelow() map[string]string {
+	return copyMap(s.parameter.CourseLevelAtOrBelowMap)
+}
+
+// SortedCourseLevelsAtOrBelow gets the course levels at or below sorted by level.
+func (s *SearchParameters) SortedCourseLevelsAtOrBelow() []Choice {
+	return sortedChoices(s.parameter.CourseLevelAtOrBelowMap)
+}
+
+// StartsAtOrAfterHours gets a copy of the starts at or after hour map.
+func (s *SearchParameters) StartsAtOrAfterHours() map[string]string {
+	return copyMap(s.parameter.StartsAtOrAfterHourMap)
+}
+
+// SortedStartsAtOrAfterHours gets the start hours sorted by hour.
+func (s *SearchParameters) SortedStartsAtOrAfterHours() []Choice {
+	return sortedChoices(s.parameter.StartsAtOrAfterHourMap)
+}
+
+// EndsAtOrAfterHours gets a copy of the ends at or after hour map.
+func (s *SearchParameters) EndsAtOrAfterHours() map[string]string {
+	return copyMap(s.parameter.EndsAtOrAfterHourMap)
+}
+
+// SortedEndsAtOrAfterHours gets the end hours sorted by hour.
+func (s *SearchParameters) SortedEndsAtOrAfterHours() []Choice {
+	return sortedChoices(s.parameter.EndsAtOrAfterHourMap)
+}
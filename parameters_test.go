@@ -0,0 +1,23 @@
+package opendata
+
+import "testing"
+
+func TestSortedChoicesNumeric(t *testing.T) {
+	s := &SearchParameters{parameter: &courseSectionSearchParameters{
+		StartsAtOrAfterHourMap: map[string]string{"10": "10:00 AM", "8": "8:00 AM", "13": "1:00 PM"},
+	}}
+	hours := s.SortedStartsAtOrAfterHours()
+	if len(hours) != 3 || hours[0].Code != "8" || hours[1].Code != "10" || hours[2].Code != "13" {
+		t.Fatal(hours)
+	}
+}
+
+func TestSearchParametersCopy(t *testing.T) {
+	s := &SearchParameters{parameter: &courseSectionSearchParameters{
+		SubjectMap: map[string]string{"CIS": "Computer and Information Science"},
+	}}
+	s.Subjects()["CIS"] = ""
+	if s.Subjects()["CIS"] == "" {
+		t.Fail()
+	}
+}
@@ -0,0 +1,84 @@
+package opendata
+
+import "context"
+
+// prefetcher holds the pages being fetched concurrently, one buffered channel per page.
+type prefetcher struct {
+	cancel  context.CancelFunc
+	first   int
+	results []chan pageResult
+}
+
+type pageResult struct {
+	data *data
+	err  error
+}
+
+// Prefetch enables concurrent fetching of the remaining pages with the given number of workers
+// once the first page, and with it the number of pages, is known.
+// Pages are still returned in order and the first failed page is reported by NextPage.
+// Prefetch requests keep the values of the context passed to the NextPageContext call that starts them,
+// but not its cancellation, so they outlive per-call timeouts; they are only stopped by Close.
+// Each NextPageContext call still stops waiting for its page when its own context is done.
+// All and Pages stop prefetching when iteration ends; callers of NextPage or Pager must call Close
+// when they stop early, or the workers keep fetching the remaining pages.
+func (i *PageIterator[T]) Prefetch(workers int) *PageIterator[T] {
+	i.workers = workers
+	return i
+}
+
+// Close stops any ongoing prefetching. The iterator can still be used afterwards.
+func (i *PageIterator[T]) Close() {
+	if i.prefetch != nil {
+		i.prefetch.cancel()
+		i.prefetch = nil
+	}
+}
+
+func (i *PageIterator[T]) startPrefetch(ctx context.Context) {
+	first, last := i.cur, i.data.ServiceMeta.NumberOfPages
+	if first <= 0 || first > last {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	p := &prefetcher{cancel: cancel, first: first, results: make([]chan pageResult, last-first+1)}
+	jobs := make(chan int, len(p.results))
+	for k := range p.results {
+		p.results[k] = make(chan pageResult, 1)
+		jobs <- first + k
+	}
+	close(jobs)
+
+	workers := i.workers
+	if workers > len(p.results) {
+		workers = len(p.results)
+	}
+	for w := 0; w < workers; w++ {
+		go func() {
+			for page := range jobs {
+				data, err := i.fetchPage(ctx, page)
+				p.results[page-first] <- pageResult{data: data, err: err}
+			}
+		}()
+	}
+	i.prefetch = p
+}
+
+// takePrefetched waits for the prefetched page.
+// It returns nil values if the page is not being prefetched.
+func (i *PageIterator[T]) takePrefetched(ctx context.Context, page int) (*data, error) {
+	if i.prefetch == nil {
+		return nil, nil
+	}
+	index := page - i.prefetch.first
+	if index < 0 || index >= len(i.prefetch.results) {
+		i.Close()
+		return nil, nil
+	}
+	select {
+	case result := <-i.prefetch.results[index]:
+		return result.data, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
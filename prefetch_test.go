@@ -0,0 +1,116 @@
+package opendata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPrefetchOrderAndConcurrency(t *testing.T) {
+	var lock sync.Mutex
+	active, maxActive := 0, 0
+	handler := pagedHandler(6)
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		lock.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		handler(w, r)
+		lock.Lock()
+		active--
+		lock.Unlock()
+	})
+	var ids []string
+	for result, err := range od.GetRegistrar().GetCourseCatalog("CIS", "").Prefetch(3).All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, result.CourseID)
+	}
+	if fmt.Sprint(ids) != "[P1A P1B P2A P2B P3A P3B P4A P4B P5A P5B P6A P6B]" {
+		t.Fatal(ids)
+	}
+	if maxActive < 2 || maxActive > 3 {
+		t.Fatalf("max concurrent requests = %d", maxActive)
+	}
+}
+
+func TestPrefetchFirstError(t *testing.T) {
+	handler := pagedHandler(4)
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_number") == "3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	})
+	iter := od.GetRegistrar().GetCourseCatalog("CIS", "").Prefetch(2)
+	defer iter.Close()
+	pages := 0
+	for iter.NextPage() {
+		if iter.GetError() != nil {
+			break
+		}
+		pages++
+	}
+	if pages != 2 || !errors.Is(iter.GetError(), ErrNotFound) {
+		t.Fatalf("pages = %d, err = %v", pages, iter.GetError())
+	}
+}
+
+func TestPrefetchStopsOnBreak(t *testing.T) {
+	var lock sync.Mutex
+	requests := 0
+	handler := pagedHandler(50)
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests++
+		lock.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		handler(w, r)
+	})
+	for _, err := range od.GetRegistrar().GetCourseCatalog("CIS", "").Prefetch(4).Pages() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+	time.Sleep(200 * time.Millisecond)
+	lock.Lock()
+	defer lock.Unlock()
+	if requests > 1+4 {
+		t.Fatalf("requests after break = %d", requests)
+	}
+}
+
+func TestPrefetchPerCallContext(t *testing.T) {
+	handler := pagedHandler(4)
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		handler(w, r)
+	})
+	iter := od.GetRegistrar().GetCourseCatalog("CIS", "").Prefetch(2)
+	defer iter.Close()
+	next := func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		return iter.NextPageContext(ctx)
+	}
+	pages := 0
+	for next() {
+		if iter.GetError() != nil {
+			t.Fatal(iter.GetError())
+		}
+		pages++
+	}
+	if pages != 4 {
+		t.Fatalf("pages = %d", pages)
+	}
+}
@@ -0,0 +1,92 @@
+package opendata
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter is a token bucket shared by every request sent through an OpenData instance.
+type limiter struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &limiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+// The balance may go negative so that concurrent callers queue up in order.
+func (l *limiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a token whose reservation was not used.
+func (l *limiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.tokens++
+}
+
+// wait blocks until a token is available or the context is done.
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// WithRateLimit limits all requests sent by the OpenData instance,
+// including those of every Registrar it creates, to rps requests per second with the given burst.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(o *OpenData) {
+		o.limiter = newLimiter(rps, burst)
+	}
+}
+
+// WithEndpointRateLimit additionally limits requests to a single endpoint, such as EndpointCourseStatus.
+func WithEndpointRateLimit(endpoint string, rps float64, burst int) Option {
+	return func(o *OpenData) {
+		if o.endpointLimiters == nil {
+			o.endpointLimiters = make(map[string]*limiter)
+		}
+		o.endpointLimiters[endpoint] = newLimiter(rps, burst)
+	}
+}
+
+func (o *OpenData) waitLimit(ctx context.Context, endpoint string) error {
+	if err := o.limiter.wait(ctx); err != nil {
+		return err
+	}
+	return o.endpointLimiters[endpoint].wait(ctx)
+}
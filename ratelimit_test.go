@@ -0,0 +1,45 @@
+package opendata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRateLimitSharedAcrossRegistrars(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testParameters))
+	}, WithRateLimit(20, 1))
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := od.GetRegistrar().GetAvailableTermMap(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("requests were not limited, took %v", elapsed)
+	}
+}
+
+func TestRateLimitContext(t *testing.T) {
+	l := newLimiter(0.001, 1)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal(err)
+	}
+}
+
+func TestEndpointName(t *testing.T) {
+	od := NewOpenDataAPI("", "")
+	u, _ := url.Parse(openDataURL + "course_section_status/202230/all?page_number=1")
+	if od.endpoint(u) != EndpointCourseStatus {
+		t.Fatal(od.endpoint(u))
+	}
+}
@@ -1,57 +1,100 @@
 package opendata
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 )
 
 // Registrar provides a wrapper for OpenData Registrar's API.
 type Registrar struct {
-	od        *OpenData
-	parameter *courseSectionSearchParameters
-	paraLock  sync.Mutex
+	od              *OpenData
+	parameter       *courseSectionSearchParameters
+	parameterTime   time.Time
+	parameterTTL    time.Duration
+	parameterFailed time.Time
+	paraLock        sync.Mutex
+	termRules       TermRules
+	now             func() time.Time
+}
+
+// DefaultParameterTTL is how long search parameters are kept before they are fetched again.
+const DefaultParameterTTL = time.Hour
+
+// parameterRefreshInterval limits how often an unknown term can trigger a refresh.
+const parameterRefreshInterval = time.Minute
+
+// RegistrarOption configures a Registrar created by OpenData.GetRegistrar.
+type RegistrarOption func(*Registrar)
+
+// WithParameterTTL sets how long search parameters, including the available term map, are kept.
+// A non-positive TTL keeps them for the lifetime of the Registrar.
+func WithParameterTTL(ttl time.Duration) RegistrarOption {
+	return func(r *Registrar) {
+		r.parameterTTL = ttl
+	}
 }
 
 const (
-	courseParameterURL = openDataURL + `course_section_search_parameters`
-	courseStatusURL    = openDataURL + `course_section_status/%s/%s`
-	courseCatalogURL   = openDataURL + `course_info/%s`
-	courseSearchURL    = openDataURL + `course_section_search`
+	courseParameterPath = EndpointSearchParameters
+	courseStatusPath    = EndpointCourseStatus + `/%s/%s`
+	courseCatalogPath   = EndpointCourseCatalog + `/%s`
+	courseSearchPath    = EndpointCourseSearch
 )
 
-func (r *Registrar) checkTerm(term string) error {
-	allowed, err := r.GetAvailableTermMap()
+// checkTerm checks the term against the available term map,
+// refreshing the map once if the term is missing in case it was newly published.
+// Refreshes, including failed ones, happen at most once per parameterRefreshInterval.
+func (r *Registrar) checkTerm(ctx context.Context, term string) error {
+	parameter, err := r.getParameterData(ctx)
 	if err != nil {
 		return err
 	}
-	_, ok := allowed[term]
-	if !ok {
-		return fmt.Errorf(`term %q does not exist`, term)
+	if _, ok := parameter.AvailableTermsMap[term]; ok {
+		return nil
 	}
-	return nil
+	r.paraLock.Lock()
+	stale := time.Since(r.parameterTime) >= parameterRefreshInterval &&
+		time.Since(r.parameterFailed) >= parameterRefreshInterval
+	r.paraLock.Unlock()
+	if stale {
+		if err := r.RefreshParameters(ctx); err != nil {
+			if ctx.Err() == nil {
+				r.paraLock.Lock()
+				r.parameterFailed = time.Now()
+				r.paraLock.Unlock()
+			}
+			return fmt.Errorf(`term %q does not exist, refreshing parameters failed: %w: %w`, term, ErrUnknownTerm, err)
+		}
+		if parameter, err = r.getParameterData(ctx); err != nil {
+			return err
+		}
+		if _, ok := parameter.AvailableTermsMap[term]; ok {
+			return nil
+		}
+	}
+	return fmt.Errorf(`term %q does not exist: %w`, term, ErrUnknownTerm)
 }
 
-func (r *Registrar) courseStatus(term, course string) ([]CourseSectionStatus, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf(courseStatusURL, term, course), nil)
+func (r *Registrar) courseStatus(ctx context.Context, term, course string, noCache bool) ([]CourseSectionStatus, error) {
+	req, err := http.NewRequest("GET", r.od.url(fmt.Sprintf(courseStatusPath, term, course)), nil)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := r.od.access(req)
-	if err != nil {
-		return nil, err
+	if noCache {
+		req.Header.Set("Cache-Control", "no-cache")
 	}
-	defer resp.Body.Close()
 	data := new(data)
-	if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+	if err := r.od.get(ctx, req, data); err != nil {
 		return nil, err
 	}
 	ret := make([]CourseSectionStatus, len(data.ResultData))
 	for i := range data.ResultData {
-		if err := json.Unmarshal(data.ResultData[i], &ret[i]); err != nil {
+		if err := r.od.unmarshal(data.ResultData[i], &ret[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -63,10 +106,15 @@ func (r *Registrar) courseStatus(term, course string) ([]CourseSectionStatus, er
 // Call #Registrar.GetAvailableTermMap to get the map.
 // See https://app.swaggerhub.com/apis-docs/UPennISC/open-data/prod#/Course%20section%20status%20service/getAllCourseSectionStatuses.
 func (r *Registrar) GetAllCourseStatus(term string) ([]CourseSectionStatus, error) {
-	if err := r.checkTerm(term); err != nil {
+	return r.GetAllCourseStatusContext(context.Background(), term)
+}
+
+// GetAllCourseStatusContext is like GetAllCourseStatus but uses the given context.
+func (r *Registrar) GetAllCourseStatusContext(ctx context.Context, term string) ([]CourseSectionStatus, error) {
+	if err := r.checkTerm(ctx, term); err != nil {
 		return nil, err
 	}
-	return r.courseStatus(term, "all")
+	return r.courseStatus(ctx, term, "all", false)
 }
 
 // GetCourseStatus gets the specific course's status in a given term.
@@ -74,16 +122,22 @@ func (r *Registrar) GetAllCourseStatus(term string) ([]CourseSectionStatus, erro
 // Call #Registrar.GetAvailableTermMap to get the map.
 // See https://app.swaggerhub.com/apis-docs/UPennISC/open-data/prod#/Course%20section%20status%20service/getOneCourseSectionStatus.
 func (r *Registrar) GetCourseStatus(term string, course *Course) ([]CourseSectionStatus, error) {
-	if err := r.checkTerm(term); err != nil {
+	return r.GetCourseStatusContext(context.Background(), term, course)
+}
+
+// GetCourseStatusContext is like GetCourseStatus but uses the given context.
+func (r *Registrar) GetCourseStatusContext(ctx context.Context, term string, course *Course) ([]CourseSectionStatus, error) {
+	if err := r.checkTerm(ctx, term); err != nil {
 		return nil, err
 	}
-	return r.courseStatus("id/"+term, course.string)
+	return r.courseStatus(ctx, "id/"+term, course.string, false)
 }
 
 // GetCourseCatalog allows the search of the course catalog using subjects and course numbers.
+// No request is sent until the returned iterator is advanced, so use PageIterator.NextPageContext for cancellation.
 // See https://app.swaggerhub.com/apis-docs/UPennISC/open-data/prod#/Course%20search%20service.
 func (r *Registrar) GetCourseCatalog(department, section string) *PageIterator[CourseCatalogData] {
-	req, err := http.NewRequest("GET", fmt.Sprintf(courseCatalogURL, department), nil)
+	req, err := http.NewRequest("GET", r.od.url(fmt.Sprintf(courseCatalogPath, department)), nil)
 	if err != nil {
 		return newErrorIter[CourseCatalogData](err)
 	}
@@ -98,20 +152,26 @@ func (r *Registrar) GetCourseCatalog(department, section string) *PageIterator[C
 // Call #Registrar.GetAcceptableSearchURLParametersMap to get the map.
 // See https://app.swaggerhub.com/apis-docs/UPennISC/open-data/prod#/Course%20section%20search%20service/searchCourseSections.
 func (r *Registrar) SearchCourseSection(parameters map[string]string) *PageIterator[CourseSearchData] {
-	req, err := http.NewRequest("GET", courseSearchURL, nil)
+	return r.SearchCourseSectionContext(context.Background(), parameters)
+}
+
+// SearchCourseSectionContext is like SearchCourseSection but uses the given context
+// when fetching the acceptable search url parameters map.
+func (r *Registrar) SearchCourseSectionContext(ctx context.Context, parameters map[string]string) *PageIterator[CourseSearchData] {
+	req, err := http.NewRequest("GET", r.od.url(courseSearchPath), nil)
 	if err != nil {
 		return newErrorIter[CourseSearchData](err)
 	}
 	if parameters != nil {
 		value := make(url.Values)
-		allowed, err := r.GetAcceptableSearchURLParametersMap()
+		allowed, err := r.GetAcceptableSearchURLParametersMapContext(ctx)
 		if err != nil {
 			return newErrorIter[CourseSearchData](err)
 		}
 		for k, v := range parameters {
 			_, ok := allowed[k]
 			if !ok {
-				return newErrorIter[CourseSearchData](fmt.Errorf(`parameter %q is not supported`, k))
+				return newErrorIter[CourseSearchData](fmt.Errorf(`parameter %q is not supported: %w`, k, ErrUnsupportedParameter))
 			}
 			value.Set(k, v)
 		}
@@ -120,44 +180,79 @@ func (r *Registrar) SearchCourseSection(parameters map[string]string) *PageItera
 	return newIter[CourseSearchData](r.od, req)
 }
 
-func (r *Registrar) getParameterData() error {
+// getParameterData gets the cached search parameters, fetching them if missing or expired.
+// If refreshing expired parameters fails, the previous parameters are returned instead,
+// and the refresh is not attempted again for parameterRefreshInterval.
+func (r *Registrar) getParameterData(ctx context.Context) (*courseSectionSearchParameters, error) {
 	r.paraLock.Lock()
 	defer r.paraLock.Unlock()
-	if r.parameter != nil {
-		return nil
+	if r.parameter != nil && (r.parameterTTL <= 0 || time.Since(r.parameterTime) < r.parameterTTL ||
+		time.Since(r.parameterFailed) < parameterRefreshInterval) {
+		return r.parameter, nil
 	}
-	req, _ := http.NewRequest("GET", courseParameterURL, nil)
-	resp, err := r.od.access(req)
-	if err != nil {
-		return err
+	if err := r.fetchParameterData(ctx, false); err != nil {
+		if r.parameter != nil {
+			r.parameterFailed = time.Now()
+			return r.parameter, nil
+		}
+		return nil, err
+	}
+	return r.parameter, nil
+}
+
+// fetchParameterData fetches the search parameters. The caller must hold paraLock.
+func (r *Registrar) fetchParameterData(ctx context.Context, noCache bool) error {
+	req, _ := http.NewRequest("GET", r.od.url(courseParameterPath), nil)
+	if noCache {
+		req.Header.Set("Cache-Control", "no-cache")
 	}
-	defer resp.Body.Close()
 	data := new(data)
-	if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+	if err := r.od.get(ctx, req, data); err != nil {
 		return err
 	}
 	if len(data.ResultData) < 1 {
 		return errors.New("unexpected result return length")
 	}
-	r.parameter = new(courseSectionSearchParameters)
-	if err := json.Unmarshal(data.ResultData[0], r.parameter); err != nil {
+	parameter := new(courseSectionSearchParameters)
+	if err := r.od.unmarshal(data.ResultData[0], parameter); err != nil {
 		return err
 	}
+	r.parameter = parameter
+	r.parameterTime = time.Now()
 	return nil
 }
 
-// GetAvailableTermMap gets acceptable search url parameters map provided by OpenData API.
+// RefreshParameters fetches the search parameters again, bypassing any cached copy.
+func (r *Registrar) RefreshParameters(ctx context.Context) error {
+	r.paraLock.Lock()
+	defer r.paraLock.Unlock()
+	return r.fetchParameterData(ctx, true)
+}
+
+// GetAvailableTermMap gets available term map provided by OpenData API.
 func (r *Registrar) GetAvailableTermMap() (map[string]string, error) {
-	if err := r.getParameterData(); err != nil {
+	return r.GetAvailableTermMapContext(context.Background())
+}
+
+// GetAvailableTermMapContext is like GetAvailableTermMap but uses the given context.
+func (r *Registrar) GetAvailableTermMapContext(ctx context.Context) (map[string]string, error) {
+	parameter, err := r.getParameterData(ctx)
+	if err != nil {
 		return nil, err
 	}
-	return r.parameter.AvailableTermsMap, nil
+	return parameter.AvailableTermsMap, nil
 }
 
-// GetAcceptableSearchURLParametersMap gets departments map provided by OpenData API.
+// GetAcceptableSearchURLParametersMap gets acceptable search url parameters map provided by OpenData API.
 func (r *Registrar) GetAcceptableSearchURLParametersMap() (map[string]string, error) {
-	if err := r.getParameterData(); err != nil {
+	return r.GetAcceptableSearchURLParametersMapContext(context.Background())
+}
+
+// GetAcceptableSearchURLParametersMapContext is like GetAcceptableSearchURLParametersMap but uses the given context.
+func (r *Registrar) GetAcceptableSearchURLParametersMapContext(ctx context.Context) (map[string]string, error) {
+	parameter, err := r.getParameterData(ctx)
+	if err != nil {
 		return nil, err
 	}
-	return r.parameter.AcceptableSearchURLParametersMap, nil
+	return parameter.AcceptableSearchURLParametersMap, nil
 }
@@ -1,8 +1,11 @@
 package opendata
 
 import (
+	"errors"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 )
 
 var api = NewOpenDataAPI(os.Getenv("CLIENT_ID"), os.Getenv("CLIENT_SECRET")).GetRegistrar()
@@ -66,3 +69,81 @@ func TestGetAvailableTermMap(t *testing.T) {
 		t.Log(ret)
 	}
 }
+
+func TestCheckTermRefreshesParameters(t *testing.T) {
+	published := false
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/course_section_search_parameters" && published:
+			w.Write([]byte(`{"result_data":[{"available_terms_map":{"202230":"Fall 2022","202310":"Spring 2023"}}],"service_meta":{}}`))
+		case r.URL.Path == "/api/v1/course_section_search_parameters":
+			w.Write([]byte(testParameters))
+		default:
+			w.Write([]byte(`{"result_data":[{"section_id":"CIS1200001","status":"O"}],"service_meta":{}}`))
+		}
+	}, WithCache(NewMemoryCache(10), CachePolicy{TTL: time.Hour}))
+	r := od.GetRegistrar()
+	if _, err := r.GetAvailableTermMap(); err != nil {
+		t.Fatal(err)
+	}
+	published = true
+	if _, err := r.GetAllCourseStatus("202310"); !errors.Is(err, ErrUnknownTerm) {
+		t.Fatal("refreshed parameters too eagerly", err)
+	}
+	r.parameterTime = time.Now().Add(-parameterRefreshInterval)
+	if _, err := r.GetAllCourseStatus("202310"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParameterRefreshFailureBackoff(t *testing.T) {
+	requests, fail := 0, false
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(testParameters))
+	})
+	r := od.GetRegistrar(WithParameterTTL(time.Minute))
+	if _, err := r.GetAvailableTermMap(); err != nil {
+		t.Fatal(err)
+	}
+	fail = true
+	r.parameterTime = time.Now().Add(-time.Hour)
+	for k := 0; k < 3; k++ {
+		if _, err := r.GetAvailableTermMap(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("requests = %d", requests)
+	}
+}
+
+func TestCheckTermRefreshFailureBackoff(t *testing.T) {
+	refreshes, fail := 0, false
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			refreshes++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(testParameters))
+	})
+	r := od.GetRegistrar()
+	if _, err := r.GetAvailableTermMap(); err != nil {
+		t.Fatal(err)
+	}
+	fail = true
+	r.parameterTime = time.Now().Add(-parameterRefreshInterval)
+	for k := 0; k < 5; k++ {
+		if _, err := r.GetAllCourseStatus("202310"); !errors.Is(err, ErrUnknownTerm) {
+			t.Fatal(err)
+		}
+	}
+	if refreshes != 1 {
+		t.Fatalf("refreshes = %d", refreshes)
+	}
+}
@@ -0,0 +1,113 @@
+package opendata
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed idempotent requests are retried.
+// Requests are retried on transient network errors as reported by IsRetryable,
+// 429 and transient 5xx responses.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// A value less than 2 disables retrying.
+	MaxAttempts int
+	// MinBackoff is the backoff before the first retry. It doubles on every further retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps both the exponential backoff and the Retry-After delay.
+	MaxBackoff time.Duration
+	// OnRetry is called before waiting for the next attempt, if not nil.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a failed attempt that is about to be retried.
+type RetryEvent struct {
+	// Attempt is the number of the failed attempt, starting from 1.
+	Attempt int
+	// URL is the request URL.
+	URL string
+	// StatusCode is the HTTP status code of the failed attempt, or 0 if Err is set.
+	StatusCode int
+	// Err is the transport error of the failed attempt, if any.
+	Err error
+	// Wait is the delay before the next attempt.
+	Wait time.Duration
+}
+
+// DefaultRetryPolicy is a reasonable policy for the OpenData gateway.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// WithRetryPolicy enables retrying of GET requests, including every PageIterator page fetch.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *OpenData) {
+		o.retry = policy
+	}
+}
+
+func retryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff computes the delay before the attempt following the given failed attempt.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+				wait = p.MaxBackoff
+			}
+			return wait
+		}
+	}
+	wait := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// Jitter between half and the full backoff.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,83 @@
+package opendata
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryTransientStatus(t *testing.T) {
+	calls := 0
+	var events []RetryEvent
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if calls == 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(testParameters))
+	}, WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+		OnRetry:     func(e RetryEvent) { events = append(events, e) },
+	}))
+	if _, err := od.GetRegistrar().GetAvailableTermMap(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 || len(events) != 2 {
+		t.Fatalf("calls = %d, retries = %d", calls, len(events))
+	}
+	if events[0].StatusCode != http.StatusTooManyRequests || events[0].Wait != 0 {
+		t.Fatalf("unexpected event %+v", events[0])
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	calls := 0
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}))
+	if _, err := od.GetRegistrar().GetAvailableTermMap(); err == nil || calls != 2 {
+		t.Fatalf("calls = %d, err = %v", calls, err)
+	}
+}
+
+func TestRetryTransportErrors(t *testing.T) {
+	for _, test := range []struct {
+		err   error
+		calls int
+	}{
+		{fmt.Errorf("read: %w", syscall.ECONNRESET), 3},
+		{errors.New("tls: failed to verify certificate"), 1},
+	} {
+		calls := 0
+		od := newTestAPI(t, nil, WithHTTPClient(&http.Client{
+			Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				calls++
+				return nil, test.err
+			}),
+		}), WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}))
+		if _, err := od.GetRegistrar().GetAvailableTermMap(); err == nil || calls != test.calls {
+			t.Errorf("%v: calls = %d, err = %v", test.err, calls, err)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Fail()
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Fail()
+	}
+}
@@ -0,0 +1,200 @@
+package opendata
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SchemaReport collects the differences between OpenData payloads and the structs of this package.
+// Decoding is never failed because of a difference; it is only recorded.
+type SchemaReport struct {
+	// OnDrift is called the first time each unknown or missing field is seen, if not nil.
+	OnDrift func(FieldDrift)
+
+	lock   sync.Mutex
+	fields map[FieldDrift]int
+}
+
+// FieldDrift describes a field that differs between a payload and a struct.
+type FieldDrift struct {
+	// Type is the name of the decoded struct, e.g. "CourseSearchData".
+	Type string
+	// Field is the JSON path of the field, e.g. "meetings[].room_code".
+	Field string
+	// Unknown is true if the field is in the payload but not in the struct,
+	// and false if the field is in the struct but missing from the payload.
+	Unknown bool
+}
+
+// FieldDriftCount is a FieldDrift together with the number of payloads it was seen in.
+type FieldDriftCount struct {
+	FieldDrift
+	Count int
+}
+
+// WithStrictDecoding inspects every decoded result and records unknown and missing fields in the report.
+func WithStrictDecoding(report *SchemaReport) Option {
+	return func(o *OpenData) {
+		o.schema = report
+	}
+}
+
+// Drift gets every recorded difference sorted by type and field.
+func (r *SchemaReport) Drift() []FieldDriftCount {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	ret := make([]FieldDriftCount, 0, len(r.fields))
+	for drift, count := range r.fields {
+		ret = append(ret, FieldDriftCount{FieldDrift: drift, Count: count})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Type != ret[j].Type {
+			return ret[i].Type < ret[j].Type
+		}
+		return ret[i].Field < ret[j].Field
+	})
+	return ret
+}
+
+// HasDrift reports whether any difference has been recorded.
+func (r *SchemaReport) HasDrift() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.fields) > 0
+}
+
+// unmarshal decodes the raw result into v, inspecting it if strict decoding is enabled.
+func (o *OpenData) unmarshal(raw json.RawMessage, v any) error {
+	if err := json.Unmarshal(raw, v); err != nil {
+		return err
+	}
+	if o.schema != nil {
+		o.schema.inspect(raw, v)
+	}
+	return nil
+}
+
+func (r *SchemaReport) inspect(raw json.RawMessage, v any) {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var found []FieldDrift
+	walkSchema(raw, t, "", func(field string, unknown bool) {
+		found = append(found, FieldDrift{Type: t.Name(), Field: field, Unknown: unknown})
+	})
+	if len(found) == 0 {
+		return
+	}
+
+	var added []FieldDrift
+	r.lock.Lock()
+	if r.fields == nil {
+		r.fields = make(map[FieldDrift]int)
+	}
+	for _, drift := range found {
+		if r.fields[drift] == 0 {
+			added = append(added, drift)
+		}
+		r.fields[drift]++
+	}
+	r.lock.Unlock()
+	if r.OnDrift != nil {
+		for _, drift := range added {
+			r.OnDrift(drift)
+		}
+	}
+}
+
+// walkSchema compares the raw JSON with the type, reporting each differing field once per payload.
+func walkSchema(raw json.RawMessage, t reflect.Type, path string, report func(field string, unknown bool)) {
+	seen := make(map[string]bool)
+	walk(raw, t, path, func(field string, unknown bool) {
+		if !seen[field] {
+			seen[field] = true
+			report(field, unknown)
+		}
+	})
+}
+
+func walk(raw json.RawMessage, t reflect.Type, path string, report func(field string, unknown bool)) {
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if json.Unmarshal(raw, &items) != nil {
+			return
+		}
+		for _, item := range items {
+			walk(item, t.Elem(), path+"[]", report)
+		}
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(raw, &object) != nil {
+			return
+		}
+		known := make(map[string]bool, len(object))
+		for k := 0; k < t.NumField(); k++ {
+			field := t.Field(k)
+			name, ok := jsonFieldName(field)
+			if !ok {
+				continue
+			}
+			key, value, ok := lookupKey(object, name)
+			if !ok {
+				report(joinPath(path, name), false)
+				continue
+			}
+			known[key] = true
+			walk(value, field.Type, joinPath(path, name), report)
+		}
+		for key := range object {
+			if !known[key] {
+				report(joinPath(path, key), true)
+			}
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return field.Name, true
+}
+
+// lookupKey finds the key like encoding/json does, preferring an exact match over a case-insensitive one.
+func lookupKey(object map[string]json.RawMessage, name string) (string, json.RawMessage, bool) {
+	if value, ok := object[name]; ok {
+		return name, value, true
+	}
+	for key, value := range object {
+		if strings.EqualFold(key, name) {
+			return key, value, true
+		}
+	}
+	return "", nil, false
+}
@@ -0,0 +1,49 @@
+package opendata
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSchemaReport(t *testing.T) {
+	report := new(SchemaReport)
+	var alerts []FieldDrift
+	report.OnDrift = func(drift FieldDrift) { alerts = append(alerts, drift) }
+	od := NewOpenDataAPI("", "", WithStrictDecoding(report))
+
+	raw := json.RawMessage(`{"previous_status":"C","section_id":"CIS1200001","section_id_normalized":"CIS-1200-001",
+		"status":"O","term":"202230","waitlist":3}`)
+	for i := 0; i < 2; i++ {
+		status := new(CourseSectionStatus)
+		if err := od.unmarshal(raw, status); err != nil {
+			t.Fatal(err)
+		}
+		if status.Status != "O" {
+			t.Fatal(status)
+		}
+	}
+	drift := report.Drift()
+	if len(drift) != 2 || len(alerts) != 2 {
+		t.Fatal(drift)
+	}
+	if drift[0].Field != "status_code_normalized" || drift[0].Unknown || drift[0].Count != 2 {
+		t.Fatal(drift[0])
+	}
+	if drift[1].Field != "waitlist" || !drift[1].Unknown || drift[1].Type != "CourseSectionStatus" {
+		t.Fatal(drift[1])
+	}
+}
+
+func TestSchemaReportNested(t *testing.T) {
+	var fields []string
+	walkSchema(json.RawMessage(`{"meetings":[{"room_code":"101","floor":1},{"room_code":"102","floor":2}]}`),
+		reflect.TypeFor[struct {
+			Meetings []struct {
+				RoomCode string `json:"room_code"`
+			} `json:"meetings"`
+		}](), "", func(field string, unknown bool) { fields = append(fields, field) })
+	if len(fields) != 1 || fields[0] != "meetings[].floor" {
+		t.Fatal(fields)
+	}
+}
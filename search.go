@@ -0,0 +1,202 @@
+package opendata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Search url parameter names used by SearchQuery.
+const (
+	searchTerm                 = "term"
+	searchSubject              = "subject"
+	searchCourseNumber         = "course_number"
+	searchActivity             = "activity"
+	searchCourseLevelAtOrAbove = "course_level_at_or_above"
+	searchCourseLevelAtOrBelow = "course_level_at_or_below"
+	searchStartsAtOrAfterHour  = "starts_at_or_after_hour"
+	searchEndsAtOrAfterHour    = "ends_at_or_after_hour"
+	searchInstructor           = "instructor"
+	searchAttribute            = "attribute"
+	searchOpenSectionsOnly     = "is_open"
+)
+
+// SearchQuery is a typed builder of course section search parameters.
+// Values are validated against the search parameter maps before any search request is sent.
+//
+//	q := NewSearchQuery().Term("202230").Subject("CIS").Activity("LEC").OpenOnly(true)
+type SearchQuery struct {
+	values map[string]string
+}
+
+// NewSearchQuery generates an empty SearchQuery.
+func NewSearchQuery() *SearchQuery {
+	return &SearchQuery{values: make(map[string]string)}
+}
+
+func (q *SearchQuery) set(key, value string) *SearchQuery {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		delete(q.values, key)
+	} else {
+		q.values[key] = value
+	}
+	return q
+}
+
+// Term sets the term code, e.g. "202230".
+func (q *SearchQuery) Term(term string) *SearchQuery {
+	return q.set(searchTerm, term)
+}
+
+// Subject sets the subject code, e.g. "CIS".
+func (q *SearchQuery) Subject(subject string) *SearchQuery {
+	return q.set(searchSubject, strings.ToUpper(subject))
+}
+
+// CourseNumber sets the course number, e.g. "1200".
+func (q *SearchQuery) CourseNumber(number string) *SearchQuery {
+	return q.set(searchCourseNumber, strings.ToUpper(number))
+}
+
+// Activity sets the activity code, e.g. "LEC".
+func (q *SearchQuery) Activity(activity string) *SearchQuery {
+	return q.set(searchActivity, strings.ToUpper(activity))
+}
+
+// LevelRange sets the course level range. Either bound may be empty.
+func (q *SearchQuery) LevelRange(atOrAbove, atOrBelow string) *SearchQuery {
+	q.set(searchCourseLevelAtOrAbove, atOrAbove)
+	return q.set(searchCourseLevelAtOrBelow, atOrBelow)
+}
+
+// StartsAtOrAfterHour sets the earliest start hour of the section.
+func (q *SearchQuery) StartsAtOrAfterHour(hour int) *SearchQuery {
+	return q.set(searchStartsAtOrAfterHour, strconv.Itoa(hour))
+}
+
+// EndsAtOrAfterHour sets the end hour filter of the section.
+func (q *SearchQuery) EndsAtOrAfterHour(hour int) *SearchQuery {
+	return q.set(searchEndsAtOrAfterHour, strconv.Itoa(hour))
+}
+
+// Instructor sets the instructor name.
+func (q *SearchQuery) Instructor(name string) *SearchQuery {
+	return q.set(searchInstructor, name)
+}
+
+// Attributes sets the required attribute codes.
+func (q *SearchQuery) Attributes(codes ...string) *SearchQuery {
+	return q.set(searchAttribute, strings.ToUpper(strings.Join(codes, ",")))
+}
+
+// OpenOnly restricts the search to open sections.
+func (q *SearchQuery) OpenOnly(open bool) *SearchQuery {
+	if !open {
+		return q.set(searchOpenSectionsOnly, "")
+	}
+	return q.set(searchOpenSectionsOnly, "true")
+}
+
+// Values gets a copy of the search url parameters represented by the query.
+func (q *SearchQuery) Values() map[string]string {
+	return copyMap(q.values)
+}
+
+// FieldError describes an invalid value of a single SearchQuery field.
+type FieldError struct {
+	Field  string
+	Value  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s %q: %s", e.Field, e.Value, e.Reason)
+}
+
+// ValidationErrors is the list of field errors returned by SearchQuery.Validate.
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return "invalid search query: " + strings.Join(messages, "; ")
+}
+
+// Validate checks every field against the given search parameters.
+// The returned error is of type ValidationErrors if any field is invalid.
+func (q *SearchQuery) Validate(parameters *SearchParameters) error {
+	var errs ValidationErrors
+	fail := func(field, reason string) {
+		errs = append(errs, &FieldError{Field: field, Value: q.values[field], Reason: reason})
+	}
+	check := func(field string, allowed map[string]string) {
+		if value, ok := q.values[field]; ok {
+			if _, ok := allowed[value]; !ok {
+				fail(field, "is not an available choice")
+			}
+		}
+	}
+
+	p := parameters.parameter
+	check(searchTerm, p.AvailableTermsMap)
+	check(searchSubject, p.SubjectMap)
+	check(searchActivity, p.ActivityMap)
+	check(searchCourseLevelAtOrAbove, p.CourseLevelAtOrAboveMap)
+	check(searchCourseLevelAtOrBelow, p.CourseLevelAtOrBelowMap)
+	check(searchStartsAtOrAfterHour, p.StartsAtOrAfterHourMap)
+	check(searchEndsAtOrAfterHour, p.EndsAtOrAfterHourMap)
+	if number, ok := q.values[searchCourseNumber]; ok && !validCourse(number) {
+		fail(searchCourseNumber, "is not a valid course number")
+	}
+	above, errAbove := strconv.Atoi(q.values[searchCourseLevelAtOrAbove])
+	below, errBelow := strconv.Atoi(q.values[searchCourseLevelAtOrBelow])
+	if errAbove == nil && errBelow == nil && above > below {
+		fail(searchCourseLevelAtOrAbove, "is above the upper course level")
+	}
+
+	keys := make([]string, 0, len(q.values))
+	for key := range q.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, ok := p.AcceptableSearchURLParametersMap[key]; !ok {
+			fail(key, "parameter is not supported")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Search validates the query and searches course sections with it.
+// Validation errors are reported by the returned iterator.
+func (r *Registrar) Search(query *SearchQuery) *PageIterator[CourseSearchData] {
+	return r.SearchContext(context.Background(), query)
+}
+
+// SearchContext is like Search but uses the given context.
+func (r *Registrar) SearchContext(ctx context.Context, query *SearchQuery) *PageIterator[CourseSearchData] {
+	if term, ok := query.values[searchTerm]; ok {
+		// Refresh the parameters once if the term is newly published; unknown terms fail validation.
+		if err := r.checkTerm(ctx, term); err != nil && !errors.Is(err, ErrUnknownTerm) {
+			return newErrorIter[CourseSearchData](err)
+		}
+	}
+	parameters, err := r.GetSearchParametersContext(ctx)
+	if err != nil {
+		return newErrorIter[CourseSearchData](err)
+	}
+	if err := query.Validate(parameters); err != nil {
+		return newErrorIter[CourseSearchData](err)
+	}
+	return r.SearchCourseSectionContext(ctx, query.Values())
+}
@@ -0,0 +1,75 @@
+package opendata
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testSearchParameters = &SearchParameters{parameter: &courseSectionSearchParameters{
+	AcceptableSearchURLParametersMap: map[string]string{
+		"term": "Term", "subject": "Subject", "activity": "Activity",
+		"course_level_at_or_above": "Level", "course_level_at_or_below": "Level",
+	},
+	AvailableTermsMap:       map[string]string{"202230": "Fall 2022"},
+	SubjectMap:              map[string]string{"CIS": "Computer and Information Science"},
+	ActivityMap:             map[string]string{"LEC": "Lecture"},
+	CourseLevelAtOrAboveMap: map[string]string{"1000": "1000", "5000": "5000"},
+	CourseLevelAtOrBelowMap: map[string]string{"1999": "1999", "5999": "5999"},
+}}
+
+func TestSearchQueryValid(t *testing.T) {
+	q := NewSearchQuery().Term("202230").Subject("cis").Activity("lec").LevelRange("1000", "1999")
+	if err := q.Validate(testSearchParameters); err != nil {
+		t.Fatal(err)
+	}
+	if q.Values()["subject"] != "CIS" {
+		t.Fail()
+	}
+}
+
+func TestSearchQueryFieldErrors(t *testing.T) {
+	q := NewSearchQuery().Term("209910").Subject("CSI").LevelRange("5000", "1999").Instructor("Smith")
+	var errs ValidationErrors
+	if !errors.As(q.Validate(testSearchParameters), &errs) {
+		t.FailNow()
+	}
+	fields := make(map[string]bool)
+	for _, err := range errs {
+		fields[err.Field] = true
+	}
+	if len(errs) != 4 || !fields["term"] || !fields["subject"] || !fields["course_level_at_or_above"] || !fields["instructor"] {
+		t.Fatal(errs)
+	}
+}
+
+func TestSearchRefreshesNewTerm(t *testing.T) {
+	published := false
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/course_section_search_parameters" && published:
+			w.Write([]byte(`{"result_data":[{"available_terms_map":{"202230":"Fall 2022","202310":"Spring 2023"},` +
+				`"acceptable_search_url_parameters_map":{"term":"Term"}}],"service_meta":{}}`))
+		case r.URL.Path == "/api/v1/course_section_search_parameters":
+			w.Write([]byte(testParameters))
+		default:
+			w.Write([]byte(`{"result_data":[{"section_id":"CIS1200001"}],"service_meta":{"number_of_pages":1,"current_page_number":1}}`))
+		}
+	}, WithCache(NewMemoryCache(10), CachePolicy{TTL: time.Hour}))
+	r := od.GetRegistrar()
+	if _, err := r.GetAvailableTermMap(); err != nil {
+		t.Fatal(err)
+	}
+	published = true
+	r.parameterTime = time.Now().Add(-parameterRefreshInterval)
+	iter := r.Search(NewSearchQuery().Term("202310"))
+	if !iter.NextPage() || iter.GetError() != nil {
+		t.Fatal(iter.GetError())
+	}
+	var errs ValidationErrors
+	iter = r.Search(NewSearchQuery().Term("209910"))
+	if iter.NextPage(); !errors.As(iter.GetError(), &errs) {
+		t.Fatal(iter.GetError())
+	}
+}
@@ -0,0 +1,71 @@
+package opendata
+
+import (
+	"context"
+	"iter"
+)
+
+// All returns an iterator over every remaining result, fetching pages lazily.
+// Iteration stops after yielding the first error.
+//
+//	for result, err := range iter.All() {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (i *PageIterator[T]) All() iter.Seq2[*T, error] {
+	return i.AllContext(context.Background())
+}
+
+// AllContext is like All but uses the given context for page requests.
+func (i *PageIterator[T]) AllContext(ctx context.Context) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		for page, err := range i.PagesContext(ctx) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for j := range page {
+				if !yield(&page[j], nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pages returns an iterator over every remaining page, fetching pages lazily.
+// Iteration stops after yielding the first error.
+func (i *PageIterator[T]) Pages() iter.Seq2[[]T, error] {
+	return i.PagesContext(context.Background())
+}
+
+// PagesContext is like Pages but uses the given context for page requests.
+// Any prefetching is stopped when iteration ends, including on break.
+func (i *PageIterator[T]) PagesContext(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		defer i.Close()
+		for i.NextPageContext(ctx) {
+			if i.err != nil {
+				yield(nil, i.err)
+				return
+			}
+			page := make([]T, i.GetPageSize())
+			for j := range page {
+				result, err := i.GetResult(j)
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				page[j] = *result
+			}
+			if !yield(page, nil) {
+				return
+			}
+		}
+		if i.err != nil {
+			yield(nil, i.err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package opendata
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func pagedHandler(pages int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page_number"))
+		fmt.Fprintf(w, `{"result_data":[{"course_id":"P%[1]dA"},{"course_id":"P%[1]dB"}],"service_meta":{"current_page_number":%[1]d,"next_page_number":%[2]d,"number_of_pages":%[3]d,"results_per_page":2}}`,
+			page, page+1, pages)
+	}
+}
+
+func TestPageIteratorAll(t *testing.T) {
+	od := newTestAPI(t, pagedHandler(3))
+	var ids []string
+	for result, err := range od.GetRegistrar().GetCourseCatalog("CIS", "").All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, result.CourseID)
+	}
+	if fmt.Sprint(ids) != "[P1A P1B P2A P2B P3A P3B]" {
+		t.Fatal(ids)
+	}
+}
+
+func TestPageIteratorPagesBreak(t *testing.T) {
+	requests := 0
+	handler := pagedHandler(3)
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		handler(w, r)
+	})
+	for page, err := range od.GetRegistrar().GetCourseCatalog("CIS", "").Pages() {
+		if err != nil || len(page) != 2 {
+			t.Fatal(err)
+		}
+		break
+	}
+	if requests != 1 {
+		t.Fatalf("requests = %d", requests)
+	}
+}
+
+func TestPageIteratorAllError(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	count := 0
+	for _, err := range od.GetRegistrar().GetCourseCatalog("CIS", "").All() {
+		count++
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatal(err)
+		}
+	}
+	if count != 1 {
+		t.Fatalf("yielded %d times", count)
+	}
+}
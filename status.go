@@ -0,0 +1,78 @@
+package opendata
+
+import "strings"
+
+// SectionStatus is the enrollment status of a course section.
+type SectionStatus int
+
+// Section statuses. StatusUnknown is used for empty or unrecognized codes.
+const (
+	StatusUnknown SectionStatus = iota
+	StatusOpen
+	StatusClosed
+	StatusCancelled
+)
+
+func (s SectionStatus) String() string {
+	switch s {
+	case StatusOpen:
+		return "Open"
+	case StatusClosed:
+		return "Closed"
+	case StatusCancelled:
+		return "Cancelled"
+	}
+	return "Unknown"
+}
+
+// ParseSectionStatus parses both raw status codes ("O", "C", "X")
+// and normalized ones ("Open", "Closed", "Cancelled").
+func ParseSectionStatus(status string) SectionStatus {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "O", "OPEN":
+		return StatusOpen
+	case "C", "CLOSED":
+		return StatusClosed
+	case "X", "CANCELLED", "CANCELED":
+		return StatusCancelled
+	}
+	return StatusUnknown
+}
+
+// SectionStatus gets the parsed current status, falling back to the normalized status code.
+func (s CourseSectionStatus) SectionStatus() SectionStatus {
+	if status := ParseSectionStatus(s.Status); status != StatusUnknown {
+		return status
+	}
+	return ParseSectionStatus(s.StatusCodeNormalized)
+}
+
+// PreviousSectionStatus gets the parsed previous status.
+func (s CourseSectionStatus) PreviousSectionStatus() SectionStatus {
+	return ParseSectionStatus(s.PreviousStatus)
+}
+
+// JustOpened reports whether the section changed from a known non-open status to open.
+func (s CourseSectionStatus) JustOpened() bool {
+	previous := s.PreviousSectionStatus()
+	return s.SectionStatus() == StatusOpen && previous != StatusOpen && previous != StatusUnknown
+}
+
+// JustClosed reports whether the section changed from open to closed.
+func (s CourseSectionStatus) JustClosed() bool {
+	return s.SectionStatus() == StatusClosed && s.PreviousSectionStatus() == StatusOpen
+}
+
+// JustCancelled reports whether the section changed from a known status to cancelled.
+func (s CourseSectionStatus) JustCancelled() bool {
+	previous := s.PreviousSectionStatus()
+	return s.SectionStatus() == StatusCancelled && previous != StatusCancelled && previous != StatusUnknown
+}
+
+// Course gets the parsed section ID, or nil if it cannot be parsed.
+func (s CourseSectionStatus) Course() *Course {
+	if course := ParseCourse(s.SectionID); course != nil {
+		return course
+	}
+	return ParseCourse(s.SectionIDNormalized)
+}
@@ -0,0 +1,26 @@
+package opendata
+
+import "testing"
+
+func TestParseSectionStatus(t *testing.T) {
+	cases := map[string]SectionStatus{"O": StatusOpen, "Closed": StatusClosed, "x": StatusCancelled, "": StatusUnknown}
+	for raw, status := range cases {
+		if ParseSectionStatus(raw) != status {
+			t.Errorf("%q parsed as %v", raw, ParseSectionStatus(raw))
+		}
+	}
+}
+
+func TestSectionStatusTransitions(t *testing.T) {
+	status := CourseSectionStatus{SectionID: "CIS1200001", PreviousStatus: "C", Status: "O"}
+	if !status.JustOpened() || status.JustClosed() || status.Course().String() != "CIS1200001" {
+		t.Fail()
+	}
+	status = CourseSectionStatus{PreviousStatus: "O", StatusCodeNormalized: "Closed"}
+	if status.SectionStatus() != StatusClosed || !status.JustClosed() || status.JustOpened() {
+		t.Fail()
+	}
+	if (CourseSectionStatus{Status: "O"}).JustOpened() {
+		t.Fatal("a section without previous status should not be reported as just opened")
+	}
+}
@@ -0,0 +1,133 @@
+package opendata
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Season is the season part of a term code.
+type Season int
+
+// Seasons of a term code.
+const (
+	Spring Season = 10
+	Summer Season = 20
+	Fall   Season = 30
+)
+
+func (s Season) String() string {
+	switch s {
+	case Spring:
+		return "Spring"
+	case Summer:
+		return "Summer"
+	case Fall:
+		return "Fall"
+	}
+	return "Season(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Term is a parsed term code in the YYYYSS format used by OpenData, e.g. "202230" for Fall 2022.
+type Term struct {
+	Year   int
+	Season Season
+}
+
+// ParseTerm parses a term code such as "202230".
+func ParseTerm(code string) (Term, error) {
+	if len(code) != 6 {
+		return Term{}, fmt.Errorf("term %q: %w", code, ErrInvalidTerm)
+	}
+	year, errYear := strconv.Atoi(code[:4])
+	season, errSeason := strconv.Atoi(code[4:])
+	t := Term{Year: year, Season: Season(season)}
+	if errYear != nil || errSeason != nil || !t.Valid() {
+		return Term{}, fmt.Errorf("term %q: %w", code, ErrInvalidTerm)
+	}
+	return t, nil
+}
+
+// Valid reports whether the term has a four-digit year and a known season.
+func (t Term) Valid() bool {
+	return t.Year >= 1000 && t.Year <= 9999 && (t.Season == Spring || t.Season == Summer || t.Season == Fall)
+}
+
+// Code gets the term code, e.g. "202230".
+func (t Term) Code() string {
+	return fmt.Sprintf("%04d%02d", t.Year, int(t.Season))
+}
+
+// String gets the human-readable term, e.g. "Fall 2022".
+func (t Term) String() string {
+	return fmt.Sprintf("%s %d", t.Season, t.Year)
+}
+
+// Next gets the term following t.
+func (t Term) Next() Term {
+	if t.Season == Fall {
+		return Term{Year: t.Year + 1, Season: Spring}
+	}
+	return Term{Year: t.Year, Season: t.Season + 10}
+}
+
+// Prev gets the term preceding t.
+func (t Term) Prev() Term {
+	if t.Season == Spring {
+		return Term{Year: t.Year - 1, Season: Fall}
+	}
+	return Term{Year: t.Year, Season: t.Season - 10}
+}
+
+// Compare returns -1, 0 or 1 if t is before, equal to or after u.
+func (t Term) Compare(u Term) int {
+	if t.Year != u.Year {
+		return cmp.Compare(t.Year, u.Year)
+	}
+	return cmp.Compare(t.Season, u.Season)
+}
+
+// MarshalText implements encoding.TextMarshaler using the term code.
+func (t Term) MarshalText() ([]byte, error) {
+	return []byte(t.Code()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using the term code.
+func (t *Term) UnmarshalText(text []byte) error {
+	parsed, err := ParseTerm(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Start gets the first day of the term in the given location, as used by TermAt.
+func (t Term) Start(loc *time.Location) time.Time {
+	switch t.Season {
+	case Summer:
+		return time.Date(t.Year, time.May, 16, 0, 0, 0, 0, loc)
+	case Fall:
+		return time.Date(t.Year, time.August, 16, 0, 0, 0, 0, loc)
+	}
+	return time.Date(t.Year, time.January, 1, 0, 0, 0, 0, loc)
+}
+
+// TermAt gets the term in session at the given time.
+// Spring runs until May 15, summer from May 16 until August 15 and fall from August 16.
+func TermAt(at time.Time) Term {
+	day := int(at.Month())*100 + at.Day()
+	switch {
+	case day < 516:
+		return Term{Year: at.Year(), Season: Spring}
+	case day < 816:
+		return Term{Year: at.Year(), Season: Summer}
+	}
+	return Term{Year: at.Year(), Season: Fall}
+}
+
+// UpcomingTerm gets the term following the one in session at the given time.
+func UpcomingTerm(at time.Time) Term {
+	return TermAt(at).Next()
+}
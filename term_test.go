@@ -0,0 +1,37 @@
+package opendata
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTerm(t *testing.T) {
+	term, err := ParseTerm("202230")
+	if err != nil || term != (Term{Year: 2022, Season: Fall}) || term.String() != "Fall 2022" || term.Code() != "202230" {
+		t.Fatal(term, err)
+	}
+	if _, err := ParseTerm("202240"); !errors.Is(err, ErrInvalidTerm) {
+		t.Fatal(err)
+	}
+}
+
+func TestTermArithmetic(t *testing.T) {
+	fall := Term{Year: 2022, Season: Fall}
+	if fall.Next() != (Term{Year: 2023, Season: Spring}) || fall.Next().Prev() != fall {
+		t.Fatal(fall.Next())
+	}
+	if fall.Compare(fall.Prev()) != 1 || fall.Compare(fall.Next()) != -1 || fall.Compare(fall) != 0 {
+		t.Fail()
+	}
+}
+
+func TestTermAt(t *testing.T) {
+	cases := map[string]string{"2023-01-10": "202310", "2023-06-01": "202320", "2023-09-01": "202330"}
+	for date, code := range cases {
+		at, _ := time.Parse(time.DateOnly, date)
+		if TermAt(at).Code() != code {
+			t.Errorf("%s: got %s, want %s", date, TermAt(at).Code(), code)
+		}
+	}
+}
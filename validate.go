@@ -0,0 +1,131 @@
+package opendata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxSuggestions limits the number of subjects suggested for an unknown subject.
+const maxSuggestions = 3
+
+// maxSuggestionDistance is the largest edit distance of a suggested subject.
+const maxSuggestionDistance = 2
+
+// SubjectError is returned by Registrar.ValidateCourse when the subject is not in the subject map.
+// It matches ErrUnknownSubject with errors.Is.
+type SubjectError struct {
+	Subject string
+	// Suggestions are the closest existing subjects by edit distance, nearest first.
+	Suggestions []string
+}
+
+func (e *SubjectError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("subject %q does not exist", e.Subject)
+	}
+	return fmt.Sprintf("subject %q does not exist, did you mean %s?", e.Subject, strings.Join(e.Suggestions, ", "))
+}
+
+func (e *SubjectError) Unwrap() error {
+	return ErrUnknownSubject
+}
+
+// ValidateCourse checks the subject of the course against the subject map.
+// The returned error is a *SubjectError with suggestions if the subject does not exist,
+// or ErrInvalidCourse if the course is nil, e.g. because ParseCourse failed.
+func (r *Registrar) ValidateCourse(course *Course) error {
+	return r.ValidateCourseContext(context.Background(), course)
+}
+
+// ValidateCourseContext is like ValidateCourse but uses the given context.
+func (r *Registrar) ValidateCourseContext(ctx context.Context, course *Course) error {
+	if course == nil {
+		return fmt.Errorf("missing course: %w", ErrInvalidCourse)
+	}
+	parameter, err := r.getParameterData(ctx)
+	if err != nil {
+		return err
+	}
+	subject := course.Subject()
+	if _, ok := parameter.SubjectMap[subject]; ok {
+		return nil
+	}
+	return &SubjectError{Subject: subject, Suggestions: suggestSubjects(subject, parameter.SubjectMap)}
+}
+
+// ValidateCourseInTerm is like ValidateCourse but also confirms the section exists in the given term.
+// A missing section is reported as ErrSectionNotFound.
+func (r *Registrar) ValidateCourseInTerm(course *Course, term string) error {
+	return r.ValidateCourseInTermContext(context.Background(), course, term)
+}
+
+// ValidateCourseInTermContext is like ValidateCourseInTerm but uses the given context.
+func (r *Registrar) ValidateCourseInTermContext(ctx context.Context, course *Course, term string) error {
+	if course == nil {
+		return fmt.Errorf("missing course: %w", ErrInvalidCourse)
+	}
+	if err := r.ValidateCourseContext(ctx, course); err != nil {
+		return err
+	}
+	status, err := r.GetCourseStatusContext(ctx, term, course)
+	if errors.Is(err, ErrNotFound) || err == nil && len(status) == 0 {
+		return fmt.Errorf("section %s in term %s: %w", course, term, ErrSectionNotFound)
+	}
+	return err
+}
+
+func suggestSubjects(subject string, subjects map[string]string) []string {
+	type candidate struct {
+		code     string
+		distance int
+	}
+	var candidates []candidate
+	for code := range subjects {
+		if d := editDistance(subject, code); d <= maxSuggestionDistance {
+			candidates = append(candidates, candidate{code: code, distance: d})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].code < candidates[j].code
+	})
+	if len(candidates) > maxSuggestions {
+		candidates = candidates[:maxSuggestions]
+	}
+	ret := make([]string, len(candidates))
+	for i, c := range candidates {
+		ret[i] = c.code
+	}
+	return ret
+}
+
+// editDistance computes the optimal string alignment distance,
+// i.e. the Levenshtein distance that also counts adjacent transpositions as one edit.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
@@ -0,0 +1,58 @@
+package opendata
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestValidateCourseSuggestions(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result_data":[{"subject_map":{"CIS":"","CSE":"","MATH":""}}],"service_meta":{}}`))
+	})
+	r := od.GetRegistrar()
+	if err := r.ValidateCourse(ParseCourse("CIS1200001")); err != nil {
+		t.Fatal(err)
+	}
+	err := r.ValidateCourse(ParseCourse("CSI1200001"))
+	var subjectErr *SubjectError
+	if !errors.Is(err, ErrUnknownSubject) || !errors.As(err, &subjectErr) {
+		t.Fatal(err)
+	}
+	if len(subjectErr.Suggestions) != 2 || subjectErr.Suggestions[0] != "CIS" || subjectErr.Suggestions[1] != "CSE" {
+		t.Fatal(subjectErr.Suggestions)
+	}
+}
+
+func TestValidateCourseInTerm(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/course_section_search_parameters" {
+			w.Write([]byte(`{"result_data":[{"subject_map":{"CIS":""},"available_terms_map":{"202230":""}}],"service_meta":{}}`))
+			return
+		}
+		w.Write([]byte(`{"result_data":[],"service_meta":{}}`))
+	})
+	err := od.GetRegistrar().ValidateCourseInTerm(ParseCourse("CIS1200999"), "202230")
+	if !errors.Is(err, ErrSectionNotFound) {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateNilCourse(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	})
+	r := od.GetRegistrar()
+	if err := r.ValidateCourse(ParseCourse("not a course")); !errors.Is(err, ErrInvalidCourse) {
+		t.Fatal(err)
+	}
+	if err := r.ValidateCourseInTerm(nil, "202230"); !errors.Is(err, ErrInvalidCourse) {
+		t.Fatal(err)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	if editDistance("CSI", "CIS") != 1 || editDistance("CIS", "MATH") != 4 || editDistance("", "AB") != 2 {
+		t.Fail()
+	}
+}
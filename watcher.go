@@ -0,0 +1,241 @@
+package opendata
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// EventType is the kind of change reported by a Watcher.
+type EventType int
+
+// Event types reported by a Watcher.
+const (
+	EventOpened EventType = iota + 1
+	EventClosed
+	EventCancelled
+	EventAppeared
+	EventDisappeared
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventOpened:
+		return "opened"
+	case EventClosed:
+		return "closed"
+	case EventCancelled:
+		return "cancelled"
+	case EventAppeared:
+		return "appeared"
+	case EventDisappeared:
+		return "disappeared"
+	}
+	return "unknown"
+}
+
+// Event is a change of a course section between two successive polls.
+type Event struct {
+	Type      EventType
+	SectionID string
+	// Course is the parsed section ID, or nil if it cannot be parsed.
+	Course *Course
+	// Previous is the status in the previous poll, or nil if the section appeared.
+	Previous *CourseSectionStatus
+	// Current is the status in the latest poll, or nil if the section disappeared.
+	Current *CourseSectionStatus
+	// Time is when the latest poll completed.
+	Time time.Time
+}
+
+// Watcher polls the status of every course section in a term and reports changes.
+// The first poll only records a baseline and reports no events.
+type Watcher struct {
+	registrar  *Registrar
+	term       string
+	interval   time.Duration
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	handler    func(Event)
+	onError    func(error)
+	events     chan Event
+	snapshot   map[string]CourseSectionStatus
+	started    atomic.Bool
+}
+
+// WatcherOption configures a Watcher created by NewWatcher.
+type WatcherOption func(*Watcher)
+
+// DefaultWatchInterval is the default polling interval of a Watcher.
+const DefaultWatchInterval = time.Minute
+
+// WithWatchInterval sets the polling interval.
+func WithWatchInterval(interval time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithErrorBackoff sets the range of the exponential backoff applied after failed polls.
+func WithErrorBackoff(min, max time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		w.minBackoff = min
+		w.maxBackoff = max
+	}
+}
+
+// WithEventHandler delivers events to the callback instead of the Events channel.
+// The callback is called from the goroutine running Watcher.Run.
+func WithEventHandler(handler func(Event)) WatcherOption {
+	return func(w *Watcher) {
+		w.handler = handler
+	}
+}
+
+// WithErrorHandler sets a callback for failed polls, which are otherwise retried silently.
+func WithErrorHandler(handler func(error)) WatcherOption {
+	return func(w *Watcher) {
+		w.onError = handler
+	}
+}
+
+// NewWatcher generates a Watcher of all course sections in the given term.
+func NewWatcher(r *Registrar, term string, options ...WatcherOption) *Watcher {
+	w := &Watcher{
+		registrar:  r,
+		term:       term,
+		interval:   DefaultWatchInterval,
+		minBackoff: time.Second,
+		maxBackoff: 5 * time.Minute,
+	}
+	for _, option := range options {
+		option(w)
+	}
+	if w.handler == nil {
+		w.events = make(chan Event)
+	}
+	return w
+}
+
+// Events gets the channel events are delivered on, or nil if an event handler is set.
+// The channel is closed when Run returns.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Run polls until the context is done and returns the context error.
+// Failed polls are retried with exponential backoff instead of the polling interval.
+// Run may only be called once; later calls return ErrWatcherStarted.
+func (w *Watcher) Run(ctx context.Context) error {
+	if !w.started.CompareAndSwap(false, true) {
+		return ErrWatcherStarted
+	}
+	if w.events != nil {
+		defer close(w.events)
+	}
+	backoff := time.Duration(0)
+	for {
+		err := w.poll(ctx)
+		wait := w.interval
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if w.onError != nil {
+				w.onError(err)
+			}
+			if backoff == 0 {
+				backoff = w.minBackoff
+			} else if backoff *= 2; backoff > w.maxBackoff {
+				backoff = w.maxBackoff
+			}
+			wait = backoff
+		} else {
+			backoff = 0
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context) error {
+	if err := w.registrar.checkTerm(ctx, w.term); err != nil {
+		return err
+	}
+	status, err := w.registrar.courseStatus(ctx, w.term, "all", true)
+	if err != nil {
+		return err
+	}
+	snapshot := make(map[string]CourseSectionStatus, len(status))
+	for _, s := range status {
+		snapshot[s.SectionID] = s
+	}
+	previous := w.snapshot
+	w.snapshot = snapshot
+	if previous == nil {
+		return nil
+	}
+	for _, event := range diffSnapshots(previous, snapshot, time.Now()) {
+		if err := w.emit(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Watcher) emit(ctx context.Context, event Event) error {
+	if w.handler != nil {
+		w.handler(event)
+		return nil
+	}
+	select {
+	case w.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// diffSnapshots gets the events between two snapshots sorted by section ID.
+func diffSnapshots(previous, current map[string]CourseSectionStatus, at time.Time) []Event {
+	var events []Event
+	add := func(t EventType, id string, before, after *CourseSectionStatus) {
+		event := Event{Type: t, SectionID: id, Previous: before, Current: after, Time: at}
+		if after != nil {
+			event.Course = after.Course()
+		} else {
+			event.Course = before.Course()
+		}
+		events = append(events, event)
+	}
+	for id, after := range current {
+		before, ok := previous[id]
+		if !ok {
+			add(EventAppeared, id, nil, &after)
+			continue
+		}
+		from, to := before.SectionStatus(), after.SectionStatus()
+		if from == to {
+			continue
+		}
+		switch to {
+		case StatusOpen:
+			add(EventOpened, id, &before, &after)
+		case StatusClosed:
+			add(EventClosed, id, &before, &after)
+		case StatusCancelled:
+			add(EventCancelled, id, &before, &after)
+		}
+	}
+	for id, before := range previous {
+		if _, ok := current[id]; !ok {
+			add(EventDisappeared, id, &before, nil)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].SectionID < events[j].SectionID
+	})
+	return events
+}
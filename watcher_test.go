@@ -0,0 +1,79 @@
+package opendata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatcherEvents(t *testing.T) {
+	var lock sync.Mutex
+	polls := 0
+	snapshots := []string{
+		`{"section_id":"CIS1200001","status":"C"},{"section_id":"CIS1200002","status":"O"},{"section_id":"CIS1200003","status":"O"}`,
+		`{"section_id":"CIS1200001","status":"O"},{"section_id":"CIS1200002","status":"C"},{"section_id":"CIS1200004","status":"O"}`,
+	}
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/course_section_search_parameters" {
+			w.Write([]byte(testParameters))
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		switch polls++; polls {
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 1:
+			fmt.Fprintf(w, `{"result_data":[%s],"service_meta":{}}`, snapshots[0])
+		default:
+			fmt.Fprintf(w, `{"result_data":[%s],"service_meta":{}}`, snapshots[1])
+		}
+	}, WithCache(NewMemoryCache(10), CachePolicy{TTL: time.Hour}))
+
+	var pollErrors []error
+	watcher := NewWatcher(od.GetRegistrar(), "202230",
+		WithWatchInterval(time.Millisecond),
+		WithErrorBackoff(time.Millisecond, time.Millisecond),
+		WithErrorHandler(func(err error) { pollErrors = append(pollErrors, err) }))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- watcher.Run(ctx) }()
+
+	var got []string
+	for event := range watcher.Events() {
+		got = append(got, fmt.Sprintf("%s %s", event.Type, event.Course))
+		if len(got) == 4 {
+			cancel()
+		}
+	}
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+	want := "[opened CIS1200001 closed CIS1200002 disappeared CIS1200003 appeared CIS1200004]"
+	if fmt.Sprint(got) != want {
+		t.Fatal(got)
+	}
+	if len(pollErrors) != 1 || !IsRetryable(pollErrors[0]) {
+		t.Fatal(pollErrors)
+	}
+}
+
+func TestWatcherRunOnce(t *testing.T) {
+	od := newTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	watcher := NewWatcher(od.GetRegistrar(), "202230", WithEventHandler(func(Event) {}))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := watcher.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+	if err := watcher.Run(context.Background()); !errors.Is(err, ErrWatcherStarted) {
+		t.Fatal(err)
+	}
+}